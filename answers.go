@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"aoc2025/miniyaml"
+)
+
+// answersFile is the known-answers store consulted by --check and written by
+// --record. It lives next to problems.yaml and uses the same simple shape:
+//
+//	1:
+//	  part1: "3"
+//	  part2: "6"
+const answersFile = "answers.yaml"
+
+// Answer check outcomes reported per part by --check.
+const (
+	checkPass    = "PASS"
+	checkFail    = "FAIL"
+	checkUnknown = "UNKNOWN"
+)
+
+type knownAnswers struct {
+	Part1 string
+	Part2 string
+}
+
+// LoadAnswers reads the known-answers file at path and returns the answers
+// keyed by day number. A missing file yields an empty store; malformed YAML
+// and unknown keys are reported with their line number.
+func LoadAnswers(path string) (map[int]knownAnswers, error) {
+	answers := make(map[int]knownAnswers)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return answers, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = forEachDay(data, func(day int, node *miniyaml.Node) error {
+		var known knownAnswers
+		for _, pair := range node.Pairs {
+			var err error
+			switch pair.Key {
+			case "part1":
+				known.Part1, err = yamlScalar(pair)
+			case "part2":
+				known.Part2, err = yamlScalar(pair)
+			default:
+				err = miniyaml.Errorf(pair.KeyLine, "unknown key %q", pair.Key)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		answers[day] = known
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return answers, nil
+}
+
+// SaveAnswers writes answers to path in day order, replacing the file.
+func SaveAnswers(path string, answers map[int]knownAnswers) error {
+	var b strings.Builder
+	b.WriteString("# Known puzzle answers for this account's inputs, used by --check.\n")
+	b.WriteString("# Regenerate with --record.\n")
+
+	dayNumbers := make([]int, 0, len(answers))
+	for day := range answers {
+		dayNumbers = append(dayNumbers, day)
+	}
+	slices.Sort(dayNumbers)
+
+	for _, day := range dayNumbers {
+		known := answers[day]
+		fmt.Fprintf(&b, "%d:\n", day)
+		if known.Part1 != "" {
+			fmt.Fprintf(&b, "  part1: %s\n", strconv.Quote(known.Part1))
+		}
+		if known.Part2 != "" {
+			fmt.Fprintf(&b, "  part2: %s\n", strconv.Quote(known.Part2))
+		}
+	}
+
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// checkPart compares a solved part against the expected answer and returns
+// checkPass, checkFail or checkUnknown when no answer has been recorded. A
+// part that returned an error always fails. Parts skipped by --part are not
+// checked.
+func checkPart(outcome partOutcome, expected string) string {
+	switch {
+	case outcome.Skipped:
+		return ""
+	case outcome.Err != nil:
+		return checkFail
+	case expected == "":
+		return checkUnknown
+	case outcome.Err == nil && outcome.Answer == expected:
+		return checkPass
+	default:
+		return checkFail
+	}
+}
+
+// recordAnswers stores the successfully solved parts of r in answers.
+func recordAnswers(answers map[int]knownAnswers, r dayResult) {
+	if r.Err != nil {
+		return
+	}
+
+	known := answers[r.Day]
+	if !r.Part1.Skipped && r.Part1.Err == nil {
+		known.Part1 = r.Part1.Answer
+	}
+	if !r.Part2.Skipped && r.Part2.Err == nil {
+		known.Part2 = r.Part2.Answer
+	}
+	answers[r.Day] = known
+}
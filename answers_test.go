@@ -0,0 +1,27 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckPart(t *testing.T) {
+	tests := []struct {
+		name     string
+		outcome  partOutcome
+		expected string
+		want     string
+	}{
+		{"match", partOutcome{Answer: "42"}, "42", checkPass},
+		{"mismatch", partOutcome{Answer: "41"}, "42", checkFail},
+		{"nothing recorded", partOutcome{Answer: "42"}, "", checkUnknown},
+		{"error", partOutcome{Err: errors.New("boom")}, "42", checkFail},
+		{"error with nothing recorded", partOutcome{Err: errors.New("boom")}, "", checkFail},
+		{"skipped", partOutcome{Skipped: true}, "42", ""},
+	}
+	for _, tt := range tests {
+		if got := checkPart(tt.outcome, tt.expected); got != tt.want {
+			t.Errorf("%s: checkPart = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
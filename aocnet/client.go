@@ -0,0 +1,188 @@
+package aocnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Defaults used by a zero-valued Client and by the package-level functions.
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	DefaultYear    = 2025
+	DefaultTimeout = 30 * time.Second
+
+	DefaultMaxRetries = 3
+	DefaultBackoff    = 500 * time.Millisecond
+	maxBackoff        = 10 * time.Second
+)
+
+// Client talks to an Advent of Code server. The zero value is ready to use and
+// targets adventofcode.com for DefaultYear; every field can be overridden, for
+// example to point BaseURL at a local test server.
+type Client struct {
+	// BaseURL is the server origin without a trailing slash.
+	BaseURL string
+	// HTTPClient performs the requests; http.DefaultClient when nil.
+	HTTPClient *http.Client
+	// Timeout bounds each request, including reading the response body.
+	// DefaultTimeout applies when zero; a negative value disables it.
+	Timeout time.Duration
+	// Year selects the event, e.g. 2025.
+	Year int
+	// UserAgent identifies the client to the server, as the AoC maintainers
+	// request of automated tools.
+	UserAgent string
+	// MaxRetries is how many times an idempotent request is retried after a
+	// transient failure. DefaultMaxRetries applies when zero; a negative value
+	// disables retries.
+	MaxRetries int
+	// Backoff is the delay before the first retry; it doubles on every
+	// further attempt up to a fixed cap. DefaultBackoff applies when zero.
+	Backoff time.Duration
+
+	// sleep waits between retries and now reads the clock; tests replace
+	// them to avoid real delays and to pin the date.
+	sleep func(time.Duration)
+	now   func() time.Time
+}
+
+// DefaultClient is used by the package-level FetchInput and SubmitAnswer.
+var DefaultClient = &Client{}
+
+func (c *Client) baseURL() string {
+	if c.BaseURL == "" {
+		return DefaultBaseURL
+	}
+	return c.BaseURL
+}
+
+func (c *Client) year() int {
+	if c.Year == 0 {
+		return DefaultYear
+	}
+	return c.Year
+}
+
+func (c *Client) userAgent() string {
+	if c.UserAgent == "" {
+		return fmt.Sprintf("github.com/%s/aoc%d (Go client)", getUsername(), c.year())
+	}
+	return c.UserAgent
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+// dayURL returns the URL of path below the puzzle page for day, e.g.
+// ".../2025/day/1/input" for path "/input".
+func (c *Client) dayURL(day int, path string) string {
+	return fmt.Sprintf("%s/%d/day/%d%s", c.baseURL(), c.year(), day, path)
+}
+
+// response is a fully read HTTP response. url is the final request URL after
+// redirects, which tells us whether the server bounced us to the login page.
+type response struct {
+	status int
+	body   []byte
+	url    *url.URL
+}
+
+// do sends a request authenticated with session and returns the fully read
+// response. The configured timeout covers the whole exchange; network errors
+// are wrapped with ErrTransient. The session never appears in the text of a
+// returned error.
+func (c *Client) do(method, url string, body io.Reader, contentType, session string) (*response, error) {
+	resp, err := c.send(method, url, body, contentType, session)
+	return resp, redactError(err, session)
+}
+
+func (c *Client) send(method, url string, body io.Reader, contentType, session string) (*response, error) {
+	ctx := context.Background()
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Cookie", "session="+session)
+	req.Header.Set("User-Agent", c.userAgent())
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTransient, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTransient, err)
+	}
+	return &response{status: resp.StatusCode, body: data, url: resp.Request.URL}, nil
+}
+
+// getWithRetry performs a GET for op, retrying transient failures with
+// exponential backoff, and returns the first usable response or the last
+// classified error.
+func (c *Client) getWithRetry(op, url, session string) (*response, error) {
+	retries := c.MaxRetries
+	if retries == 0 {
+		retries = DefaultMaxRetries
+	}
+	delay := c.Backoff
+	if delay <= 0 {
+		delay = DefaultBackoff
+	}
+	sleep := c.sleep
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.do("GET", url, nil, "", session)
+		if err == nil {
+			err = checkResponse(op, resp)
+		}
+		if err == nil {
+			return resp, nil
+		}
+		if !errors.Is(err, ErrTransient) || attempt >= retries {
+			return nil, err
+		}
+
+		sleep(delay)
+		delay = min(delay*2, maxBackoff)
+	}
+}
+
+func getUsername() string {
+	user := os.Getenv("USER")
+	if user == "" {
+		user = os.Getenv("USERNAME")
+	}
+	if user == "" {
+		user = "anonymous"
+	}
+	return user
+}
@@ -0,0 +1,48 @@
+package aocnet
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestClientFetchInput(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2024/day/3/input" {
+			t.Errorf("path: got %s, want /2024/day/3/input", r.URL.Path)
+		}
+		if got := r.UserAgent(); got != "aoc-test" {
+			t.Errorf("user agent: got %q, want aoc-test", got)
+		}
+		w.Write([]byte("a\nb\n"))
+	})
+	c.Year = 2024
+	c.UserAgent = "aoc-test"
+
+	lines, err := c.FetchInput(3, "token")
+	if err != nil {
+		t.Fatalf("FetchInput: %v", err)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(lines, want) {
+		t.Fatalf("lines: got %q, want %q", lines, want)
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	release := make(chan struct{})
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	defer close(release)
+	c.Timeout = 50 * time.Millisecond
+	c.MaxRetries = -1
+
+	start := time.Now()
+	if _, err := c.FetchInput(1, "token"); err == nil {
+		t.Fatalf("expected timeout error from unresponsive server")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("timeout not honoured: request took %v", elapsed)
+	}
+}
@@ -0,0 +1,75 @@
+package aocnet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors describing why a request to the server failed. Errors
+// returned by Client methods wrap one of these where the cause is known, so
+// callers can test them with errors.Is.
+var (
+	// ErrSessionExpired means the session cookie was rejected, either with an
+	// explicit error status or by being sent to the login page.
+	ErrSessionExpired = errors.New("session expired or invalid")
+	// ErrNotUnlocked means the puzzle for the requested day is not out yet.
+	ErrNotUnlocked = errors.New("puzzle not unlocked yet")
+	// ErrRateLimited means the server asked us to slow down.
+	ErrRateLimited = errors.New("rate limited by server")
+	// ErrTransient marks network failures and server errors that are worth
+	// retrying.
+	ErrTransient = errors.New("transient failure")
+)
+
+// StatusError reports an unexpected HTTP status for an operation such as
+// "fetch input". Err is the sentinel the status was classified as, if any.
+type StatusError struct {
+	Op         string
+	StatusCode int
+	Err        error
+}
+
+func (e *StatusError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("failed to %s: status %d: %v", e.Op, e.StatusCode, e.Err)
+	}
+	return fmt.Sprintf("failed to %s: status %d", e.Op, e.StatusCode)
+}
+
+func (e *StatusError) Unwrap() error { return e.Err }
+
+// checkResponse classifies a completed exchange, returning nil for a usable
+// 200 response and a *StatusError otherwise.
+func checkResponse(op string, resp *response) error {
+	if resp.status == http.StatusOK {
+		if redirectedToLogin(resp) {
+			return &StatusError{Op: op, StatusCode: resp.status, Err: ErrSessionExpired}
+		}
+		return nil
+	}
+
+	statusErr := &StatusError{Op: op, StatusCode: resp.status}
+	switch {
+	case redirectedToLogin(resp) || bytes.Contains(resp.body, []byte("Please log in")):
+		statusErr.Err = ErrSessionExpired
+	case resp.status == http.StatusBadRequest:
+		// AoC answers a malformed or unknown cookie with a plain 400.
+		statusErr.Err = ErrSessionExpired
+	case resp.status == http.StatusNotFound:
+		statusErr.Err = ErrNotUnlocked
+	case resp.status == http.StatusTooManyRequests:
+		statusErr.Err = ErrRateLimited
+	case resp.status >= 500:
+		statusErr.Err = ErrTransient
+	}
+	return statusErr
+}
+
+// redirectedToLogin reports whether the server sent us to its login pages,
+// which it does when the session cookie is missing or no longer valid.
+func redirectedToLogin(resp *response) bool {
+	return resp.url != nil && strings.HasPrefix(resp.url.Path, "/auth/")
+}
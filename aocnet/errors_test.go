@@ -0,0 +1,135 @@
+package aocnet
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestFetchInputClassifiesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    error
+	}{
+		{
+			name: "bad session",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			},
+			want: ErrSessionExpired,
+		},
+		{
+			name: "login page on server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "Please log in to get your puzzle input.", http.StatusInternalServerError)
+			},
+			want: ErrSessionExpired,
+		},
+		{
+			name: "redirect to login",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/auth/login" {
+					w.Write([]byte("<html>To play, please identify yourself</html>"))
+					return
+				}
+				http.Redirect(w, r, "/auth/login", http.StatusFound)
+			},
+			want: ErrSessionExpired,
+		},
+		{
+			name: "not unlocked",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			want: ErrNotUnlocked,
+		},
+		{
+			name: "rate limited",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			want: ErrRateLimited,
+		},
+		{
+			name: "server down",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			want: ErrTransient,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t, tc.handler)
+			c.sleep = func(time.Duration) {}
+
+			_, err := c.FetchInput(1, "token")
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("got %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestFetchInputRetriesTransientFailures(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("ok\n"))
+	})
+
+	var delays []time.Duration
+	c.sleep = func(d time.Duration) { delays = append(delays, d) }
+	c.Backoff = 100 * time.Millisecond
+
+	lines, err := c.FetchInput(1, "token")
+	if err != nil {
+		t.Fatalf("FetchInput: %v", err)
+	}
+	if len(lines) != 1 || lines[0] != "ok" {
+		t.Fatalf("lines: got %q", lines)
+	}
+	if want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}; len(delays) != 2 || delays[0] != want[0] || delays[1] != want[1] {
+		t.Fatalf("delays: got %v, want %v", delays, want)
+	}
+}
+
+func TestFetchInputGivesUpAfterMaxRetries(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c.sleep = func(time.Duration) {}
+	c.MaxRetries = 2
+
+	if _, err := c.FetchInput(1, "token"); !errors.Is(err, ErrTransient) {
+		t.Fatalf("got %v, want ErrTransient", err)
+	}
+	if calls != 3 {
+		t.Fatalf("calls: got %d, want 3", calls)
+	}
+}
+
+func TestFetchInputDoesNotRetryPermanentFailures(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.NotFound(w, r)
+	})
+	c.sleep = func(time.Duration) {}
+
+	if _, err := c.FetchInput(1, "token"); !errors.Is(err, ErrNotUnlocked) {
+		t.Fatalf("got %v, want ErrNotUnlocked", err)
+	}
+	if calls != 1 {
+		t.Fatalf("calls: got %d, want 1", calls)
+	}
+}
@@ -0,0 +1,58 @@
+package aocnet
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Example is a worked example from a puzzle page: its input and the answers
+// the page gives for it. An empty answer means the page does not state one.
+type Example struct {
+	Input string
+	Part1 string
+	Part2 string
+}
+
+var (
+	prePattern    = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+	answerPattern = regexp.MustCompile(`(?s)<code><em>(.*?)</em></code>`)
+)
+
+// ExtractExamples finds the worked examples in a puzzle page. Each part's
+// example is the first <pre><code> block in that part's article, and its
+// answer is the last emphasised code span. When part two has no example of
+// its own, its answer is attached to part one's example.
+func ExtractExamples(page string) []Example {
+	var examples []Example
+
+	for part, article := range articlePattern.FindAllStringSubmatch(page, 2) {
+		body := article[1]
+
+		answer := ""
+		if answers := answerPattern.FindAllStringSubmatch(body, -1); answers != nil {
+			answer = htmlText(answers[len(answers)-1][1])
+		}
+
+		input := ""
+		if pre := prePattern.FindStringSubmatch(body); pre != nil {
+			input = html.UnescapeString(tagPattern.ReplaceAllString(pre[1], ""))
+		}
+
+		switch {
+		case part == 0:
+			if input == "" {
+				return nil
+			}
+			examples = append(examples, Example{Input: input, Part1: answer})
+		case input == "" || input == examples[0].Input || !strings.Contains(strings.TrimSpace(input), "\n"):
+			// Part two usually reuses the first example, and single-line
+			// snippets in its text are illustrations rather than inputs.
+			examples[0].Part2 = answer
+		default:
+			examples = append(examples, Example{Input: input, Part2: answer})
+		}
+	}
+
+	return examples
+}
@@ -0,0 +1,65 @@
+package aocnet
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExtractExamplesPartOneOnly(t *testing.T) {
+	examples := ExtractExamples(readFixture(t, "day01.html"))
+	if len(examples) != 1 {
+		t.Fatalf("got %d examples, want 1", len(examples))
+	}
+
+	ex := examples[0]
+	if !strings.HasPrefix(ex.Input, "L68\nL30\n") || !strings.HasSuffix(ex.Input, "L82\n") {
+		t.Errorf("input: got %q", ex.Input)
+	}
+	if ex.Part1 != "3" || ex.Part2 != "" {
+		t.Errorf("answers: got %q/%q, want 3/empty", ex.Part1, ex.Part2)
+	}
+}
+
+func TestExtractExamplesSharedExample(t *testing.T) {
+	examples := ExtractExamples(readFixture(t, "day07.html"))
+	if len(examples) != 1 {
+		t.Fatalf("got %d examples, want 1", len(examples))
+	}
+	if got := examples[0]; got.Part1 != "21" || got.Part2 != "40" {
+		t.Errorf("answers: got %q/%q, want 21/40", got.Part1, got.Part2)
+	}
+}
+
+func TestExtractExamplesSeparatePartTwoExample(t *testing.T) {
+	page := `<main>
+<article class="day-desc"><h2>--- Day 11: Reactor ---</h2>
+<pre><code>you: a b
+a: out
+b: out
+</code></pre>
+<p>There are <code><em>2</em></code> paths.</p></article>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<p>Use <code>svr</code> instead:</p>
+<pre><code>svr: <em>dac</em>
+dac: out
+</code></pre>
+<p>Only <code><em>1</em></code> path qualifies.</p></article>
+</main>`
+
+	examples := ExtractExamples(page)
+	if len(examples) != 2 {
+		t.Fatalf("got %d examples, want 2", len(examples))
+	}
+	if examples[0].Part1 != "2" || examples[0].Part2 != "" {
+		t.Errorf("example 1 answers: got %q/%q", examples[0].Part1, examples[0].Part2)
+	}
+	if examples[1].Input != "svr: dac\ndac: out\n" || examples[1].Part2 != "1" {
+		t.Errorf("example 2: got %+v", examples[1])
+	}
+}
+
+func TestExtractExamplesNoExample(t *testing.T) {
+	if got := ExtractExamples("<article><p>No code here.</p></article>"); got != nil {
+		t.Fatalf("got %v, want nil", got)
+	}
+}
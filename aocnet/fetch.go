@@ -1,65 +1,26 @@
 package aocnet
 
-import (
-	"fmt"
-	"io"
-	"net/http"
-	"os"
-)
-
-const year = 2025
+import "aoc2025/puzzleinput"
 
+// FetchInput downloads the puzzle input for day with DefaultClient.
 func FetchInput(day int, session string) ([]string, error) {
-	url := fmt.Sprintf("https://adventofcode.com/%d/day/%d/input", year, day)
+	return DefaultClient.FetchInput(day, session)
+}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
+// FetchInput downloads the puzzle input for day and returns it as lines,
+// normalized as described in package puzzleinput.
+// Transient failures are retried with backoff; other failures wrap one of the
+// package's sentinel errors where the cause is recognised. Before the puzzle
+// unlocks no request is sent and the error wraps ErrNotUnlocked.
+func (c *Client) FetchInput(day int, session string) ([]string, error) {
+	if err := c.checkUnlocked(day); err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Cookie", "session="+session)
-	req.Header.Set("User-Agent", fmt.Sprintf("github.com/%s/aoc%d (Go client)", getUsername(), year))
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.getWithRetry("fetch input", c.dayURL(day, "/input"), session)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to fetch input: status %d", resp.StatusCode)
-	}
-
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	lines := []string{}
-	line := ""
-	for _, b := range data {
-		if b == '\n' {
-			lines = append(lines, line)
-			line = ""
-			continue
-		}
-		line += string(b)
-	}
-	if line != "" {
-		lines = append(lines, line)
-	}
-
-	return lines, nil
-}
-
-func getUsername() string {
-	user := os.Getenv("USER")
-	if user == "" {
-		user = os.Getenv("USERNAME")
-	}
-	if user == "" {
-		user = "anonymous"
-	}
-	return user
+	return puzzleinput.Split(resp.body), nil
 }
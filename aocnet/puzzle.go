@@ -0,0 +1,95 @@
+package aocnet
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Puzzle is the metadata extracted from a day's puzzle page. HTML keeps the
+// full page so callers can mine it for more, such as the worked examples.
+type Puzzle struct {
+	Day         int
+	Title       string
+	Description string
+	HTML        string
+}
+
+// maxDescriptionLen caps the generated description, which is meant to be a
+// one-line summary like the hand-written entries in problems.yaml.
+const maxDescriptionLen = 160
+
+var (
+	titlePattern     = regexp.MustCompile(`<h2[^>]*>--- Day (\d+): (.*?) ---</h2>`)
+	paragraphPattern = regexp.MustCompile(`(?s)<p>(.*?)</p>`)
+	sentenceEnd      = regexp.MustCompile(`[.!?](\s|$)`)
+)
+
+// FetchPuzzle downloads the puzzle page for day with DefaultClient.
+func FetchPuzzle(day int, session string) (Puzzle, error) {
+	return DefaultClient.FetchPuzzle(day, session)
+}
+
+// FetchPuzzle downloads and parses the puzzle page for day. The session is
+// optional; with it the page also includes part two once it is unlocked.
+func (c *Client) FetchPuzzle(day int, session string) (Puzzle, error) {
+	if err := c.checkUnlocked(day); err != nil {
+		return Puzzle{}, err
+	}
+
+	resp, err := c.getWithRetry("fetch puzzle", c.dayURL(day, ""), session)
+	if err != nil {
+		return Puzzle{}, err
+	}
+	return ParsePuzzle(string(resp.body))
+}
+
+// ParsePuzzle extracts the title from the "--- Day N: Title ---" header and a
+// short description from the opening sentence of the first article.
+func ParsePuzzle(page string) (Puzzle, error) {
+	m := titlePattern.FindStringSubmatch(page)
+	if m == nil {
+		return Puzzle{}, fmt.Errorf("no puzzle title found on page")
+	}
+	day, _ := strconv.Atoi(m[1])
+
+	puzzle := Puzzle{
+		Day:   day,
+		Title: htmlText(m[2]),
+		HTML:  page,
+	}
+
+	if article := articlePattern.FindStringSubmatch(page); article != nil {
+		if p := paragraphPattern.FindStringSubmatch(article[1]); p != nil {
+			puzzle.Description = summarize(htmlText(p[1]))
+		}
+	}
+
+	return puzzle, nil
+}
+
+// htmlText strips tags from an HTML fragment, decodes entities and collapses
+// whitespace into single spaces.
+func htmlText(fragment string) string {
+	text := html.UnescapeString(tagPattern.ReplaceAllString(fragment, ""))
+	return strings.TrimSpace(spacePattern.ReplaceAllString(text, " "))
+}
+
+// summarize returns the first sentence of text, shortened on a word boundary
+// if it is still longer than maxDescriptionLen.
+func summarize(text string) string {
+	if loc := sentenceEnd.FindStringIndex(text); loc != nil {
+		text = text[:loc[0]+1]
+	}
+	if len(text) <= maxDescriptionLen {
+		return text
+	}
+
+	cut := strings.LastIndex(text[:maxDescriptionLen], " ")
+	if cut <= 0 {
+		cut = maxDescriptionLen
+	}
+	return strings.TrimRight(text[:cut], ",;:") + "…"
+}
@@ -0,0 +1,98 @@
+package aocnet
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// readFixture loads a saved puzzle page from testdata.
+func readFixture(t *testing.T, name string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+	return string(data)
+}
+
+func TestParsePuzzle(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		day         int
+		title       string
+		description string
+	}{
+		{
+			fixture:     "day01.html",
+			day:         1,
+			title:       "Secret Entrance",
+			description: "The Elves have good news and bad news.",
+		},
+		{
+			fixture:     "day07.html",
+			day:         7,
+			title:       "Laboratories",
+			description: `You thank the Elves & head down to the "lab", where a tachyon manifold hums quietly.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			p, err := ParsePuzzle(readFixture(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("ParsePuzzle: %v", err)
+			}
+			if p.Day != tc.day {
+				t.Errorf("day: got %d, want %d", p.Day, tc.day)
+			}
+			if p.Title != tc.title {
+				t.Errorf("title: got %q, want %q", p.Title, tc.title)
+			}
+			if p.Description != tc.description {
+				t.Errorf("description: got %q, want %q", p.Description, tc.description)
+			}
+		})
+	}
+}
+
+func TestParsePuzzleWithoutTitle(t *testing.T) {
+	if _, err := ParsePuzzle("<html><body>Nothing here</body></html>"); err == nil {
+		t.Fatalf("expected error for page without a title")
+	}
+}
+
+func TestSummarizeTruncatesLongSentences(t *testing.T) {
+	long := strings.Repeat("word ", 60)
+	got := summarize(long)
+	if len(got) > maxDescriptionLen+len("…") || !strings.HasSuffix(got, "…") {
+		t.Fatalf("summarize: got %q", got)
+	}
+}
+
+func TestClientFetchPuzzle(t *testing.T) {
+	page := readFixture(t, "day01.html")
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2025/day/1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(page))
+	})
+
+	p, err := c.FetchPuzzle(1, "")
+	if err != nil {
+		t.Fatalf("FetchPuzzle: %v", err)
+	}
+	if p.Title != "Secret Entrance" {
+		t.Fatalf("title: got %q", p.Title)
+	}
+
+	if _, err := c.FetchPuzzle(2, ""); !errors.Is(err, ErrNotUnlocked) {
+		t.Fatalf("missing day: got %v, want ErrNotUnlocked", err)
+	}
+}
@@ -0,0 +1,63 @@
+package aocnet
+
+import (
+	"errors"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// userPattern matches the name shown in the page header of a logged-in user.
+var userPattern = regexp.MustCompile(`<div class="user">([^<]*)`)
+
+// CheckSession validates session with DefaultClient.
+func CheckSession(session string) (string, error) {
+	return DefaultClient.CheckSession(session)
+}
+
+// CheckSession validates session by loading the settings page, which only
+// shows a user name to a logged-in visitor, and returns that name. A rejected
+// session yields an error wrapping ErrSessionExpired.
+func (c *Client) CheckSession(session string) (string, error) {
+	if session == "" {
+		return "", errors.New("empty session token")
+	}
+
+	resp, err := c.getWithRetry("check session", c.baseURL()+"/settings", session)
+	if err != nil {
+		return "", err
+	}
+	m := userPattern.FindSubmatch(resp.body)
+	if m == nil {
+		return "", &StatusError{Op: "check session", StatusCode: resp.status, Err: ErrSessionExpired}
+	}
+	return strings.TrimSpace(html.UnescapeString(string(m[1]))), nil
+}
+
+// Redact replaces every occurrence of session in s, so messages that may echo
+// a request can be shown or logged safely.
+func Redact(s, session string) string {
+	if session == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, session, "[REDACTED]")
+}
+
+// redactedError hides the session token in the text of err while leaving it
+// inspectable with errors.Is and errors.As.
+type redactedError struct {
+	err     error
+	session string
+}
+
+func (e *redactedError) Error() string { return Redact(e.err.Error(), e.session) }
+
+func (e *redactedError) Unwrap() error { return e.err }
+
+// redactError wraps err so that its message never contains session.
+func redactError(err error, session string) error {
+	if err == nil || session == "" || !strings.Contains(err.Error(), session) {
+		return err
+	}
+	return &redactedError{err: err, session: session}
+}
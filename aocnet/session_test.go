@@ -0,0 +1,85 @@
+package aocnet
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		wantUser string
+		wantErr  error
+	}{
+		{
+			name: "logged in",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/settings" {
+					t.Errorf("path: got %s, want /settings", r.URL.Path)
+				}
+				if c, err := r.Cookie("session"); err != nil || c.Value != "abc123" {
+					t.Errorf("session cookie: got %v, %v", c, err)
+				}
+				w.Write([]byte(`<header><div class="user">Ada &amp; Co <span class="star-count">42*</span></div></header>`))
+			},
+			wantUser: "Ada & Co",
+		},
+		{
+			name: "anonymous user",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`<div class="user">(anonymous user #123456)</div>`))
+			},
+			wantUser: "(anonymous user #123456)",
+		},
+		{
+			name: "logged out page",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`<header><a href="/2025/auth/login">[Log In]</a></header>`))
+			},
+			wantErr: ErrSessionExpired,
+		},
+		{
+			name: "redirect to login",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/auth/login" {
+					w.Write([]byte("<html>To play, please identify yourself</html>"))
+					return
+				}
+				http.Redirect(w, r, "/auth/login", http.StatusFound)
+			},
+			wantErr: ErrSessionExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, tt.handler)
+			user, err := c.CheckSession("abc123")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckSession error: got %v, want %v", err, tt.wantErr)
+			}
+			if user != tt.wantUser {
+				t.Fatalf("user: got %q, want %q", user, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestErrorsRedactSession(t *testing.T) {
+	const session = "53616c7465645f5f0123456789abcdef"
+	err := redactError(fmt.Errorf("%w: Get \"https://example/?s=%s\": refused", ErrTransient, session), session)
+
+	if strings.Contains(err.Error(), session) {
+		t.Fatalf("error text contains the session: %v", err)
+	}
+	if !strings.Contains(err.Error(), "[REDACTED]") {
+		t.Fatalf("error text lacks the redaction marker: %v", err)
+	}
+	if !errors.Is(err, ErrTransient) {
+		t.Fatalf("redacted error no longer wraps ErrTransient: %v", err)
+	}
+}
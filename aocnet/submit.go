@@ -0,0 +1,138 @@
+package aocnet
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SubmitOutcome classifies the response Advent of Code gives to an answer.
+type SubmitOutcome int
+
+const (
+	OutcomeUnknown SubmitOutcome = iota
+	OutcomeCorrect
+	OutcomeWrong
+	OutcomeTooHigh
+	OutcomeTooLow
+	OutcomeRateLimited
+	OutcomeAlreadySolved
+)
+
+func (o SubmitOutcome) String() string {
+	switch o {
+	case OutcomeCorrect:
+		return "correct"
+	case OutcomeWrong:
+		return "wrong"
+	case OutcomeTooHigh:
+		return "too high"
+	case OutcomeTooLow:
+		return "too low"
+	case OutcomeRateLimited:
+		return "rate limited"
+	case OutcomeAlreadySolved:
+		return "already solved"
+	default:
+		return "unknown"
+	}
+}
+
+// SubmitResult is the parsed server response to a submitted answer. Wait is
+// how long the server asks us to hold off before the next submission, and
+// Message is the plain-text body of the response article.
+type SubmitResult struct {
+	Outcome SubmitOutcome
+	Wait    time.Duration
+	Message string
+}
+
+// SubmitAnswer posts answer for day and part with DefaultClient.
+func SubmitAnswer(day, part int, answer, session string) (SubmitResult, error) {
+	return DefaultClient.SubmitAnswer(day, part, answer, session)
+}
+
+// SubmitAnswer posts answer for the given day and part (1 or 2) and returns the
+// parsed outcome, or an error if the request itself fails.
+func (c *Client) SubmitAnswer(day, part int, answer, session string) (SubmitResult, error) {
+	if part != 1 && part != 2 {
+		return SubmitResult{}, fmt.Errorf("invalid part %d", part)
+	}
+	if err := c.checkUnlocked(day); err != nil {
+		return SubmitResult{}, err
+	}
+
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+
+	// Submissions are never retried: a retry after a lost response could
+	// count as a second guess.
+	resp, err := c.do("POST", c.dayURL(day, "/answer"), strings.NewReader(form.Encode()),
+		"application/x-www-form-urlencoded", session)
+	if err != nil {
+		return SubmitResult{}, err
+	}
+	if err := checkResponse("submit answer", resp); err != nil {
+		return SubmitResult{}, err
+	}
+
+	return ParseSubmitResponse(string(resp.body)), nil
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]+>`)
+	spacePattern   = regexp.MustCompile(`\s+`)
+	leftToWait     = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	pleaseWait     = regexp.MustCompile(`[Pp]lease wait (one|\d+) minutes?`)
+)
+
+// ParseSubmitResponse classifies the HTML page returned after submitting an
+// answer. Pages it does not recognise yield OutcomeUnknown with the message
+// text preserved so callers can show it to the user.
+func ParseSubmitResponse(page string) SubmitResult {
+	text := page
+	if m := articlePattern.FindStringSubmatch(page); m != nil {
+		text = m[1]
+	}
+	text = htmlText(text)
+
+	result := SubmitResult{Message: text}
+
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		result.Outcome = OutcomeCorrect
+	case strings.Contains(text, "You gave an answer too recently"):
+		result.Outcome = OutcomeRateLimited
+		if m := leftToWait.FindStringSubmatch(text); m != nil {
+			minutes, _ := strconv.Atoi(m[1])
+			seconds, _ := strconv.Atoi(m[2])
+			result.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+		}
+	case strings.Contains(text, "Did you already complete it"):
+		result.Outcome = OutcomeAlreadySolved
+	case strings.Contains(text, "That's not the right answer"):
+		switch {
+		case strings.Contains(text, "your answer is too high"):
+			result.Outcome = OutcomeTooHigh
+		case strings.Contains(text, "your answer is too low"):
+			result.Outcome = OutcomeTooLow
+		default:
+			result.Outcome = OutcomeWrong
+		}
+		if m := pleaseWait.FindStringSubmatch(text); m != nil {
+			minutes := 1
+			if m[1] != "one" {
+				minutes, _ = strconv.Atoi(m[1])
+			}
+			result.Wait = time.Duration(minutes) * time.Minute
+		}
+	}
+
+	return result
+}
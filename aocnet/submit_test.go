@@ -0,0 +1,139 @@
+package aocnet
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestClient starts a local stand-in server for one test and returns a
+// Client pointed at it.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+}
+
+// articlePage wraps body the way adventofcode.com wraps submission responses.
+func articlePage(body string) string {
+	return `<html><body><main><article><p>` + body + `</p></article></main></body></html>`
+}
+
+func TestSubmitAnswerPostsForm(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method: got %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/2025/day/7/answer" {
+			t.Errorf("path: got %s", r.URL.Path)
+		}
+		if c, err := r.Cookie("session"); err != nil || c.Value != "token" {
+			t.Errorf("session cookie: got %v, %v", c, err)
+		}
+		if got := r.FormValue("level"); got != "2" {
+			t.Errorf("level: got %q, want 2", got)
+		}
+		if got := r.FormValue("answer"); got != "1234" {
+			t.Errorf("answer: got %q, want 1234", got)
+		}
+		w.Write([]byte(articlePage(`That's the right answer! You are <span class="day-success">one gold star</span> closer.`)))
+	})
+
+	res, err := c.SubmitAnswer(7, 2, "1234", "token")
+	if err != nil {
+		t.Fatalf("SubmitAnswer: %v", err)
+	}
+	if res.Outcome != OutcomeCorrect {
+		t.Fatalf("outcome: got %v, want correct", res.Outcome)
+	}
+}
+
+func TestSubmitAnswerRejectsBadPart(t *testing.T) {
+	if _, err := SubmitAnswer(1, 3, "1", "token"); err == nil {
+		t.Fatalf("expected error for part 3")
+	}
+}
+
+func TestSubmitAnswerStatusError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusInternalServerError)
+	})
+
+	if _, err := c.SubmitAnswer(1, 1, "1", "token"); err == nil {
+		t.Fatalf("expected error for status 500")
+	}
+}
+
+func TestParseSubmitResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		outcome SubmitOutcome
+		wait    time.Duration
+	}{
+		{
+			name:    "correct",
+			body:    `That's the right answer! You are <span class="day-success">one gold star</span> closer to decorating the North Pole.`,
+			outcome: OutcomeCorrect,
+		},
+		{
+			name:    "wrong",
+			body:    `That's not the right answer. If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.`,
+			outcome: OutcomeWrong,
+			wait:    time.Minute,
+		},
+		{
+			name:    "too high",
+			body:    `That's not the right answer; your answer is too high. Please wait one minute before trying again.`,
+			outcome: OutcomeTooHigh,
+			wait:    time.Minute,
+		},
+		{
+			name:    "too low",
+			body:    `That's not the right answer; your answer is too low. Please wait 5 minutes before trying again.`,
+			outcome: OutcomeTooLow,
+			wait:    5 * time.Minute,
+		},
+		{
+			name:    "rate limited",
+			body:    `You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 1m 23s left to wait.`,
+			outcome: OutcomeRateLimited,
+			wait:    83 * time.Second,
+		},
+		{
+			name:    "rate limited seconds only",
+			body:    `You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 42s left to wait.`,
+			outcome: OutcomeRateLimited,
+			wait:    42 * time.Second,
+		},
+		{
+			name:    "already solved",
+			body:    `You don't seem to be solving the right level.  Did you already complete it? <a href="/2025/day/1">[Return to Day 1]</a>`,
+			outcome: OutcomeAlreadySolved,
+		},
+		{
+			name:    "unknown",
+			body:    `Something unexpected happened.`,
+			outcome: OutcomeUnknown,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ParseSubmitResponse(articlePage(tc.body))
+			if res.Outcome != tc.outcome {
+				t.Errorf("outcome: got %v, want %v", res.Outcome, tc.outcome)
+			}
+			if res.Wait != tc.wait {
+				t.Errorf("wait: got %v, want %v", res.Wait, tc.wait)
+			}
+			if res.Message == "" {
+				t.Errorf("message: got empty text")
+			}
+		})
+	}
+}
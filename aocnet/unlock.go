@@ -0,0 +1,49 @@
+package aocnet
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // unlock times must not depend on the host's zoneinfo
+)
+
+// unlockZone is the time zone whose midnight releases each puzzle.
+const unlockZone = "America/New_York"
+
+// UnlockTime returns the instant the puzzle for year and day is released:
+// midnight US Eastern on that day of December.
+func UnlockTime(year, day int) time.Time {
+	loc, err := time.LoadLocation(unlockZone)
+	if err != nil {
+		// The zone database is embedded, so this only fails if it is corrupt.
+		panic(fmt.Sprintf("aocnet: loading %s: %v", unlockZone, err))
+	}
+	return time.Date(year, time.December, day, 0, 0, 0, 0, loc)
+}
+
+// UnlockTime returns when the puzzle for day unlocks in the client's year.
+func (c *Client) UnlockTime(day int) time.Time {
+	return UnlockTime(c.year(), day)
+}
+
+// UntilUnlock returns how long remains until day unlocks, or zero or a negative
+// duration if it already has.
+func (c *Client) UntilUnlock(day int) time.Duration {
+	return c.UnlockTime(day).Sub(c.clock())
+}
+
+// checkUnlocked returns an error wrapping ErrNotUnlocked when day has not been
+// released yet, so no request is ever sent for a locked puzzle.
+func (c *Client) checkUnlocked(day int) error {
+	if remaining := c.UntilUnlock(day); remaining > 0 {
+		return fmt.Errorf("%w: day %d unlocks at %s (in %s)", ErrNotUnlocked, day,
+			c.UnlockTime(day).Format(time.RFC1123), remaining.Round(time.Second))
+	}
+	return nil
+}
+
+func (c *Client) clock() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+	return c.now()
+}
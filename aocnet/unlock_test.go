@@ -0,0 +1,41 @@
+package aocnet
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUnlockTimeIsMidnightEastern(t *testing.T) {
+	got := UnlockTime(2025, 1)
+	want := time.Date(2025, time.December, 1, 5, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("UnlockTime(2025, 1): got %s, want %s", got.UTC(), want)
+	}
+}
+
+func TestFetchInputRefusesBeforeUnlock(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte("input\n"))
+	})
+	unlock := UnlockTime(DefaultYear, 5)
+	c.now = func() time.Time { return unlock.Add(-time.Minute) }
+
+	if got := c.UntilUnlock(5); got != time.Minute {
+		t.Fatalf("UntilUnlock: got %s, want 1m", got)
+	}
+	if _, err := c.FetchInput(5, "token"); !errors.Is(err, ErrNotUnlocked) {
+		t.Fatalf("FetchInput before unlock: got %v, want ErrNotUnlocked", err)
+	}
+	if calls != 0 {
+		t.Fatalf("server was contacted %d time(s) before unlock", calls)
+	}
+
+	c.now = func() time.Time { return unlock }
+	if _, err := c.FetchInput(5, "token"); err != nil {
+		t.Fatalf("FetchInput at unlock: %v", err)
+	}
+}
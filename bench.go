@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc2025/days"
+)
+
+// Defaults for "aoc2025 bench".
+const (
+	defaultBenchWarmup = 2
+	defaultBenchCount  = 10
+
+	// minBenchSample is the shortest wall-clock time one sample may take.
+	// Faster phases are repeated within a sample until it is reached, so
+	// sub-microsecond parts are not lost in timer resolution.
+	minBenchSample = 5 * time.Millisecond
+)
+
+// benchStats summarises the samples taken for one phase. Each sample is the
+// mean time of Iterations back-to-back runs.
+type benchStats struct {
+	MedianNS   int64 `json:"median_ns"`
+	MeanNS     int64 `json:"mean_ns"`
+	StddevNS   int64 `json:"stddev_ns"`
+	Samples    int   `json:"samples"`
+	Iterations int   `json:"iterations"`
+}
+
+// benchDay holds the measured phases of one day; Part2 is nil for days whose
+// second part is not implemented.
+type benchDay struct {
+	Year         int         `json:"year"`
+	Day          int         `json:"day"`
+	SetInput     benchStats  `json:"set_input"`
+	Part1        benchStats  `json:"part1"`
+	Part2        *benchStats `json:"part2,omitempty"`
+	FullPipeline benchStats  `json:"full_pipeline"`
+}
+
+// benchReport is the JSON document written by "bench --json".
+type benchReport struct {
+	GOOS   string     `json:"goos"`
+	GOARCH string     `json:"goarch"`
+	CPU    string     `json:"cpu,omitempty"`
+	Warmup int        `json:"warmup"`
+	Count  int        `json:"count"`
+	Days   []benchDay `json:"days"`
+}
+
+// runBench implements "aoc2025 bench [--year YYYY] [--warmup N] [--count N]
+// [--json FILE] [<days>]": it benchmarks the SetInput, SolvePart1, SolvePart2
+// and full-pipeline phases of each selected day (all by default) on the
+// cached inputs, in the same way as benchmarkDay in the days tests. The
+// medians are printed as the README benchmark table; --json also writes
+// every statistic to FILE. It returns the process exit code.
+func runBench(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	warmup, count := defaultBenchWarmup, defaultBenchCount
+	jsonPath := ""
+	var dayArgs []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(arg, "=")
+		if name != "--warmup" && name != "--count" && name != "--json" {
+			dayArgs = append(dayArgs, arg)
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				fmt.Printf("%s requires a value\n", name)
+				return 1
+			}
+			i++
+			value = args[i]
+		}
+
+		if name == "--json" {
+			jsonPath = value
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 || (name == "--count" && n == 0) {
+			fmt.Printf("invalid %s value: %s\n", name, value)
+			return 1
+		}
+		if name == "--warmup" {
+			warmup = n
+		} else {
+			count = n
+		}
+	}
+	if len(dayArgs) == 0 {
+		dayArgs = []string{"all"}
+	}
+
+	dayNumbers, err := parseDaySelection(dayArgs, days.DaysOfYear(year))
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("Usage: ./aoc2025 bench [--year YYYY] [--warmup N] [--count N] [--json FILE] [<days>]")
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	report := benchReport{
+		GOOS:   runtime.GOOS,
+		GOARCH: runtime.GOARCH,
+		CPU:    cpuModel(),
+		Warmup: warmup,
+		Count:  count,
+	}
+	var rows []timingRow
+	for _, day := range dayNumbers {
+		lines, err := FetchOrReadInput(year, day)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping day %d: %v\n", day, err)
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "Benchmarking day %d...\n", day)
+		result, err := benchmarkOneDay(ctx, year, day, lines, warmup, count)
+		if ctx.Err() != nil {
+			fmt.Fprintln(os.Stderr, "Interrupted.")
+			return 1
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping day %d: %v\n", day, err)
+			continue
+		}
+
+		report.Days = append(report.Days, result)
+		rows = append(rows, result.timingRow())
+	}
+
+	fmt.Printf("### Benchmark Summary — %s\n\n", platformName(report))
+	writeTimingTable(os.Stdout, phaseSetInput, rows)
+	fmt.Printf("\nMedian of %d samples per phase after %d warmup samples.\n", count, warmup)
+
+	if jsonPath != "" {
+		if err := writeBenchJSON(jsonPath, report); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", jsonPath, err)
+			return 1
+		}
+	}
+	return 0
+}
+
+// benchmarkOneDay measures the four benchmark phases of day on lines. The
+// input is first parsed strictly so a malformed file is reported instead of
+// benchmarked.
+func benchmarkOneDay(ctx context.Context, year, day int, lines []string, warmup, count int) (benchDay, error) {
+	newSolution := func() days.Solution {
+		s, _ := days.GetYear(year, day)
+		return s
+	}
+	if err := days.AsSolver(newSolution()).Parse(lines); err != nil {
+		return benchDay{}, fmt.Errorf("parsing input: %w", err)
+	}
+	info, _ := days.Lookup(year, day)
+
+	result := benchDay{Year: year, Day: day}
+
+	result.SetInput = measure(ctx, warmup, count, func() {
+		s := newSolution()
+		s.SetInput(lines)
+	})
+
+	s := newSolution()
+	s.SetInput(lines)
+	result.Part1 = measure(ctx, warmup, count, func() { _ = s.SolvePart1() })
+
+	if !info.Part2Missing {
+		s := newSolution()
+		s.SetInput(lines)
+		part2 := measure(ctx, warmup, count, func() { _ = s.SolvePart2() })
+		result.Part2 = &part2
+	}
+
+	result.FullPipeline = measure(ctx, warmup, count, func() {
+		s := newSolution()
+		s.SetInput(lines)
+		_ = s.SolvePart1()
+		if !info.Part2Missing {
+			_ = s.SolvePart2()
+		}
+	})
+
+	return result, nil
+}
+
+// measure times run: it first finds how many iterations fill minBenchSample,
+// discards warmup samples of that size and then records count samples. It
+// stops early, with whatever it has, once ctx is cancelled.
+func measure(ctx context.Context, warmup, count int, run func()) benchStats {
+	sample := func(iterations int) time.Duration {
+		start := time.Now()
+		for range iterations {
+			run()
+		}
+		return time.Since(start)
+	}
+
+	iterations := 1
+	for {
+		elapsed := sample(iterations)
+		if elapsed >= minBenchSample || iterations >= 1<<24 {
+			break
+		}
+		// Aim a little past the target so the next round usually settles it.
+		next := int(float64(iterations) * 1.2 * float64(minBenchSample) / float64(max(elapsed, 1)))
+		iterations = min(max(next, iterations+1), 100*iterations)
+	}
+
+	for range warmup {
+		if ctx.Err() != nil {
+			break
+		}
+		sample(iterations)
+	}
+
+	samples := make([]time.Duration, 0, count)
+	for range count {
+		if ctx.Err() != nil {
+			break
+		}
+		samples = append(samples, sample(iterations)/time.Duration(iterations))
+	}
+
+	return summarize(samples, iterations)
+}
+
+// summarize computes the median, mean and sample standard deviation of
+// samples.
+func summarize(samples []time.Duration, iterations int) benchStats {
+	stats := benchStats{Samples: len(samples), Iterations: iterations}
+	if len(samples) == 0 {
+		return stats
+	}
+
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+	median := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		median = (sorted[len(sorted)/2-1] + median) / 2
+	}
+
+	var sum float64
+	for _, s := range samples {
+		sum += float64(s)
+	}
+	mean := sum / float64(len(samples))
+
+	var sq float64
+	for _, s := range samples {
+		sq += (float64(s) - mean) * (float64(s) - mean)
+	}
+	stddev := 0.0
+	if len(samples) > 1 {
+		stddev = math.Sqrt(sq / float64(len(samples)-1))
+	}
+
+	stats.MedianNS = int64(median)
+	stats.MeanNS = int64(mean)
+	stats.StddevNS = int64(stddev)
+	return stats
+}
+
+// timingRow converts the medians of d into a row of the README table.
+func (d benchDay) timingRow() timingRow {
+	row := timingRow{
+		Day:   d.Day,
+		Input: time.Duration(d.SetInput.MedianNS),
+		Part1: time.Duration(d.Part1.MedianNS),
+		Part2: -1,
+		Full:  time.Duration(d.FullPipeline.MedianNS),
+	}
+	if d.Part2 != nil {
+		row.Part2 = time.Duration(d.Part2.MedianNS)
+	}
+	return row
+}
+
+func writeBenchJSON(path string, report benchReport) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// platformName describes the machine for the table heading, e.g.
+// "AMD EPYC 7B13 (linux/amd64)".
+func platformName(r benchReport) string {
+	if r.CPU == "" {
+		return fmt.Sprintf("%s/%s", r.GOOS, r.GOARCH)
+	}
+	return fmt.Sprintf("%s (%s/%s)", r.CPU, r.GOOS, r.GOARCH)
+}
+
+// cpuModel returns the processor name from /proc/cpuinfo, or "" where that is
+// not available.
+func cpuModel() string {
+	f, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		key, value, ok := strings.Cut(sc.Text(), ":")
+		if ok && strings.TrimSpace(key) == "model name" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	samples := []time.Duration{4, 1, 3, 2}
+	got := summarize(samples, 7)
+
+	want := benchStats{MedianNS: 2, MeanNS: 2, StddevNS: 1, Samples: 4, Iterations: 7}
+	if got != want {
+		t.Fatalf("summarize: got %+v, want %+v", got, want)
+	}
+	if samples[0] != 4 {
+		t.Fatalf("summarize reordered its input: %v", samples)
+	}
+}
+
+func TestSummarizeOddAndSingle(t *testing.T) {
+	if got := summarize([]time.Duration{30, 10, 20}, 1); got.MedianNS != 20 || got.MeanNS != 20 || got.StddevNS != 10 {
+		t.Fatalf("odd count: got %+v", got)
+	}
+	if got := summarize([]time.Duration{5}, 1); got.MedianNS != 5 || got.StddevNS != 0 {
+		t.Fatalf("single sample: got %+v", got)
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"aoc2025/days"
+	"aoc2025/inputcrypt"
+	"aoc2025/puzzleinput"
+)
+
+// passphraseEnv names the environment variable holding the passphrase for
+// encrypted inputs.
+const passphraseEnv = "AOC_INPUT_PASSPHRASE"
+
+// errCacheCorrupt is returned, wrapped, when a cached input no longer matches
+// the checksum recorded in its metadata file.
+var errCacheCorrupt = errors.New("checksum mismatch")
+
+// cacheMeta is the sidecar written next to every fetched input as
+// input/YYYY/dayNN.meta.json. Account identifies the session that fetched the
+// input by a fingerprint; the token itself is never stored. Inputs restored
+// from an encrypted copy have neither a fetch time nor an account, since the
+// encrypted file does not record them.
+type cacheMeta struct {
+	SHA256    string    `json:"sha256"`
+	FetchedAt time.Time `json:"fetched_at,omitzero"`
+	Year      int       `json:"year"`
+	Day       int       `json:"day"`
+	Account   string    `json:"account,omitempty"`
+}
+
+// metaPath returns the metadata file belonging to the cached input at path.
+func metaPath(path string) string {
+	return strings.TrimSuffix(path, ".txt") + ".meta.json"
+}
+
+// encryptedPath returns where the encrypted copy of the input at path lives.
+func encryptedPath(path string) string {
+	return path + ".enc"
+}
+
+// joinLines renders lines as they are stored in the cache.
+func joinLines(lines []string) []byte {
+	var b strings.Builder
+	for _, l := range lines {
+		b.WriteString(l)
+		b.WriteByte('\n')
+	}
+	return []byte(b.String())
+}
+
+// checksum returns the hex SHA-256 of data as recorded in cacheMeta.
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// accountID returns a short fingerprint of session, so inputs fetched with
+// different accounts can be told apart without recording the token.
+func accountID(session string) string {
+	if session == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(session))
+	return hex.EncodeToString(sum[:6])
+}
+
+// writeInputCache stores lines fetched with session as input/YYYY/dayXX.txt
+// for day of year along with its metadata file.
+func writeInputCache(year, day int, lines []string, session string) error {
+	return storeInput(year, day, joinLines(lines), cacheMeta{
+		FetchedAt: time.Now().UTC().Truncate(time.Second),
+		Account:   accountID(session),
+	})
+}
+
+// storeInput writes data as the cached input for day of year and meta, with
+// its checksum, year and day filled in, as its metadata file. Both are written
+// to a temporary file and renamed into place, so an interrupted run never
+// leaves a half-written input behind.
+func storeInput(year, day int, data []byte, meta cacheMeta) error {
+	path := inputPath(year, day)
+	if err := ensureDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	meta.SHA256 = checksum(data)
+	meta.Year, meta.Day = year, day
+	if err := writeFileAtomic(path, data, 0644); err != nil {
+		return err
+	}
+	return writeCacheMeta(path, meta)
+}
+
+// writeCacheMeta replaces the metadata file of the cached input at path.
+func writeCacheMeta(path string, meta cacheMeta) error {
+	raw, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(metaPath(path), append(raw, '\n'), 0644)
+}
+
+// writeFileAtomic replaces the file at path with data by writing a temporary
+// file in the same directory and renaming it over path. The temporary file is
+// created readable by the owner only and given perm just before the rename.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, perm)
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+// readInputCache reads the cached input for day of year, falling back to the
+// legacy input/dayXX.txt location for the default year. Inputs that have a
+// metadata file are checked against its checksum; a mismatch is reported as
+// errCacheCorrupt. Files without one, such as inputs placed by hand, are
+// used as they are. When only an encrypted dayXX.txt.enc exists it is
+// decrypted with the passphrase from AOC_INPUT_PASSPHRASE.
+func readInputCache(year, day int) ([]string, error) {
+	path := inputPath(year, day)
+	lines, err := readCacheFile(path, year, day)
+	if errors.Is(err, os.ErrNotExist) && year == days.DefaultYear {
+		// Inputs cached before the per-year layout live directly in input/.
+		if legacy, legacyErr := readCacheFile(legacyInputPath(day), year, day); legacyErr == nil {
+			return legacy, nil
+		}
+	}
+	return lines, err
+}
+
+func readCacheFile(path string, year, day int) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		plain, encErr := readEncryptedInput(encryptedPath(path), year, day, os.Getenv(passphraseEnv))
+		if errors.Is(encErr, os.ErrNotExist) {
+			return nil, err
+		}
+		if encErr != nil {
+			return nil, encErr
+		}
+		return puzzleinput.Split(plain), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(metaPath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return puzzleinput.Split(data), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var meta cacheMeta
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, fmt.Errorf("%s: %w", metaPath(path), err)
+	}
+	if checksum(data) != meta.SHA256 {
+		return nil, fmt.Errorf("cached input %s: %w", path, errCacheCorrupt)
+	}
+	return puzzleinput.Split(data), nil
+}
+
+// readEncryptedInput decrypts the encrypted input at path for day of year with
+// passphrase and returns its contents.
+func readEncryptedInput(path string, year, day int, passphrase string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if passphrase == "" {
+		return nil, fmt.Errorf("%s is encrypted; set %s to read it", path, passphraseEnv)
+	}
+	plain, err := inputcrypt.Open(passphrase, data, inputcrypt.Label(year, day))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return plain, nil
+}
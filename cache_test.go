@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestInputCacheRoundTrip(t *testing.T) {
+	t.Setenv("AOC_INPUT_DIR", t.TempDir())
+	lines := []string{"L68", "", "R48"}
+
+	if err := writeInputCache(2024, 3, lines, "secret-session"); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readInputCache(2024, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, lines) {
+		t.Fatalf("readInputCache = %q, want %q", got, lines)
+	}
+
+	raw, err := os.ReadFile(metaPath(inputPath(2024, 3)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "secret-session") {
+		t.Fatalf("metadata contains the session token:\n%s", raw)
+	}
+	var meta cacheMeta
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		t.Fatal(err)
+	}
+	if meta.Year != 2024 || meta.Day != 3 || meta.Account != accountID("secret-session") || meta.FetchedAt.IsZero() {
+		t.Errorf("metadata = %+v", meta)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(inputPath(2024, 3)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), ".tmp") {
+			t.Errorf("temporary file %s left behind", e.Name())
+		}
+	}
+}
+
+func TestInputCacheDetectsCorruption(t *testing.T) {
+	t.Setenv("AOC_INPUT_DIR", t.TempDir())
+	if err := writeInputCache(2024, 5, []string{"3-5", "10-14"}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a write cut short by an interrupted run.
+	if err := os.WriteFile(inputPath(2024, 5), []byte("3-5\n10-"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readInputCache(2024, 5); !errors.Is(err, errCacheCorrupt) {
+		t.Fatalf("readInputCache error = %v, want errCacheCorrupt", err)
+	}
+
+	// Even with a session the input is not refetched unless AOC_ONLINE=1,
+	// since a mismatch is usually a deliberate edit.
+	t.Setenv("AOC_SESSION", "secret-session")
+	t.Setenv("AOC_ONLINE", "")
+	_, err := FetchOrReadInput(2024, 5)
+	if !errors.Is(err, errCacheCorrupt) {
+		t.Fatalf("FetchOrReadInput error = %v, want errCacheCorrupt", err)
+	}
+	if !strings.Contains(err.Error(), "inputs --year 2024 accept 5") {
+		t.Errorf("FetchOrReadInput error = %v, want it to explain how to accept the edit", err)
+	}
+}
+
+func TestAcceptInput(t *testing.T) {
+	t.Setenv("AOC_INPUT_DIR", t.TempDir())
+	if err := writeInputCache(2024, 5, []string{"3-5", "10-14"}, "secret-session"); err != nil {
+		t.Fatal(err)
+	}
+	before, err := os.ReadFile(metaPath(inputPath(2024, 5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg, err := acceptInput(2024, 5); err != nil || msg != "unchanged" {
+		t.Fatalf("acceptInput on an untouched input = %q, %v; want unchanged", msg, err)
+	}
+
+	edited := []string{"3-5", "10-16"}
+	if err := os.WriteFile(inputPath(2024, 5), joinLines(edited), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if msg, err := acceptInput(2024, 5); err != nil || !strings.HasPrefix(msg, "accepted") {
+		t.Fatalf("acceptInput = %q, %v", msg, err)
+	}
+	got, err := readInputCache(2024, 5)
+	if err != nil || !slices.Equal(got, edited) {
+		t.Fatalf("after accept: readInputCache = %q, %v; want %q", got, err, edited)
+	}
+
+	var old, updated cacheMeta
+	after, err := os.ReadFile(metaPath(inputPath(2024, 5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(before, &old); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(after, &updated); err != nil {
+		t.Fatal(err)
+	}
+	if !updated.FetchedAt.Equal(old.FetchedAt) || updated.Account != old.Account {
+		t.Errorf("accept changed the fetch metadata: %+v, was %+v", updated, old)
+	}
+}
+
+func TestInputCacheWithoutMetadata(t *testing.T) {
+	t.Setenv("AOC_INPUT_DIR", t.TempDir())
+	path := inputPath(2024, 9)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("7,1\r\n11,1\r\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readInputCache(2024, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"7,1", "11,1"}; !slices.Equal(got, want) {
+		t.Fatalf("readInputCache = %q, want %q", got, want)
+	}
+}
+
+func TestEncryptedInputCache(t *testing.T) {
+	t.Setenv("AOC_INPUT_DIR", t.TempDir())
+	t.Setenv(passphraseEnv, "hunter2")
+	lines := []string{"123 328", "+   *"}
+	if err := writeInputCache(2024, 6, lines, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if msg, err := encryptInput(2024, 6, "hunter2"); err != nil || !strings.HasPrefix(msg, "encrypted") {
+		t.Fatalf("encryptInput = %q, %v", msg, err)
+	}
+	if msg, err := encryptInput(2024, 6, "hunter2"); err != nil || msg != "unchanged" {
+		t.Fatalf("second encryptInput = %q, %v; want unchanged", msg, err)
+	}
+
+	// With only the encrypted copy left, reads decrypt it transparently.
+	path := inputPath(2024, 6)
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readInputCache(2024, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, lines) {
+		t.Fatalf("readInputCache = %q, want %q", got, lines)
+	}
+
+	t.Setenv(passphraseEnv, "")
+	if _, err := readInputCache(2024, 6); err == nil || !strings.Contains(err.Error(), passphraseEnv) {
+		t.Fatalf("readInputCache without a passphrase: error = %v", err)
+	}
+	t.Setenv(passphraseEnv, "hunter2")
+
+	if msg, err := decryptInput(2024, 6, "hunter2", false); err != nil || !strings.HasPrefix(msg, "decrypted") {
+		t.Fatalf("decryptInput = %q, %v", msg, err)
+	}
+	if got, err := readInputCache(2024, 6); err != nil || !slices.Equal(got, lines) {
+		t.Fatalf("after decrypt: readInputCache = %q, %v", got, err)
+	}
+	raw, err := os.ReadFile(metaPath(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "fetched_at") || strings.Contains(string(raw), "account") {
+		t.Errorf("decrypted metadata records a fetch it did not make:\n%s", raw)
+	}
+
+	if err := os.WriteFile(path, []byte("changed\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decryptInput(2024, 6, "hunter2", false); err == nil {
+		t.Fatal("decryptInput replaced a differing input without --force")
+	}
+	if _, err := decryptInput(2024, 6, "hunter2", true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEncryptInputUsesGivenPassphrase(t *testing.T) {
+	t.Setenv("AOC_INPUT_DIR", t.TempDir())
+	t.Setenv(passphraseEnv, "")
+	if err := writeInputCache(2024, 7, []string{"190: 10 19"}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := encryptInput(2024, 7, "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+	if msg, err := encryptInput(2024, 7, "hunter2"); err != nil || msg != "unchanged" {
+		t.Fatalf("second encryptInput = %q, %v; want unchanged", msg, err)
+	}
+	if err := os.Remove(inputPath(2024, 7)); err != nil {
+		t.Fatal(err)
+	}
+	if msg, err := decryptInput(2024, 7, "hunter2", false); err != nil || !strings.HasPrefix(msg, "decrypted") {
+		t.Fatalf("decryptInput = %q, %v", msg, err)
+	}
+}
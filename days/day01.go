@@ -13,7 +13,12 @@ type day01 struct {
 }
 
 func init() {
-	Register(1, func() Solution { return &day01{} })
+	RegisterInfo(Info{
+		Day:    1,
+		Title:  "Secret Entrance",
+		Tags:   []string{"simulation", "modular arithmetic"},
+		Author: "kjn-void",
+	}, func() Solution { return &day01{} })
 }
 
 // SetInput parses rotation instructions like "L68" and "R8" into signed dial
@@ -38,6 +43,45 @@ func (d *day01) SetInput(lines []string) {
 	}
 }
 
+// Parse is the strict counterpart of SetInput and reports the first line that
+// is not a rotation.
+func (d *day01) Parse(lines []string) error {
+	d.rotations = d.rotations[:0]
+
+	for i, line := range lines {
+		s := lineSpan(line).trim()
+		if s.text == "" {
+			continue
+		}
+
+		val, err := parseRotation(i+1, s)
+		if err != nil {
+			return err
+		}
+		d.rotations = append(d.rotations, val)
+	}
+	return nil
+}
+
+// parseRotation parses one "Ln" or "Rn" instruction and returns its signed
+// dial delta.
+func parseRotation(lineNo int, s span) (int, error) {
+	dir := s.text[0]
+	if dir != 'L' && dir != 'R' {
+		return 0, parseErrorf(lineNo, s.col, "rotation must start with L or R, got %q", dir)
+	}
+
+	val, err := parseNonNegative(lineNo, span{text: s.text[1:], col: s.col + 1}, "rotation distance")
+	if err != nil {
+		return 0, err
+	}
+
+	if dir == 'L' {
+		return -int(val), nil
+	}
+	return int(val), nil
+}
+
 // dialPosition wraps n onto the safe dial's 0..99 range and returns the
 // normalized position.
 func dialPosition(n int) int {
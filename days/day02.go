@@ -14,7 +14,12 @@ type idRange struct {
 }
 
 func init() {
-	Register(2, func() Solution { return &day02{} })
+	RegisterInfo(Info{
+		Day:    2,
+		Title:  "Gift Shop",
+		Tags:   []string{"number theory", "strings"},
+		Author: "kjn-void",
+	}, func() Solution { return &day02{} })
 }
 
 // SetInput parses comma-separated inclusive product ID ranges into named range
@@ -40,6 +45,57 @@ func (d *day02) SetInput(lines []string) {
 	}
 }
 
+// Parse is the strict counterpart of SetInput and reports the first malformed
+// range, or any input beyond the single line of ranges.
+func (d *day02) Parse(lines []string) error {
+	d.productIDRanges = d.productIDRanges[:0]
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	for _, part := range lineSpan(lines[0]).trim().split(",") {
+		if part.text == "" {
+			continue
+		}
+
+		r, err := parseIDRange(1, part)
+		if err != nil {
+			return err
+		}
+		d.productIDRanges = append(d.productIDRanges, r)
+	}
+
+	for i, line := range lines[1:] {
+		if strings.TrimSpace(line) != "" {
+			return parseErrorf(i+2, 0, "unexpected input after the line of ranges")
+		}
+	}
+	return nil
+}
+
+// parseIDRange parses one "first-last" product ID range.
+func parseIDRange(lineNo int, s span) (idRange, error) {
+	from, to, ok := s.cut("-")
+	if !ok {
+		return idRange{}, parseErrorf(lineNo, s.trim().col, "range %q is missing '-'", strings.TrimSpace(s.text))
+	}
+
+	start, err := parseNonNegative(lineNo, from, "range start")
+	if err != nil {
+		return idRange{}, err
+	}
+	end, err := parseNonNegative(lineNo, to, "range end")
+	if err != nil {
+		return idRange{}, err
+	}
+	if start > end {
+		return idRange{}, parseErrorf(lineNo, from.trim().col, "range start %d is after its end %d", start, end)
+	}
+
+	return idRange{first: start, last: end}, nil
+}
+
 // pow10Table builds powers of ten used to construct repeated numeric patterns
 // without repeatedly calling slower math/string helpers.
 func pow10Table() []int64 {
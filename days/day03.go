@@ -9,7 +9,12 @@ type day03 struct {
 }
 
 func init() {
-	Register(3, func() Solution { return &day03{} })
+	RegisterInfo(Info{
+		Day:    3,
+		Title:  "Lobby",
+		Tags:   []string{"greedy", "monotonic stack"},
+		Author: "kjn-void",
+	}, func() Solution { return &day03{} })
 }
 
 // SetInput converts each battery-bank line into digits while preserving order,
@@ -27,6 +32,29 @@ func (d *day03) SetInput(lines []string) {
 	}
 }
 
+// Parse is the strict counterpart of SetInput and reports the first character
+// that is not a battery joltage digit.
+func (d *day03) Parse(lines []string) error {
+	d.batteryBanks = d.batteryBanks[:0]
+
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		if err := checkAlphabet(i+1, line, "0123456789", "battery bank"); err != nil {
+			return err
+		}
+
+		digits := make([]int, len(line))
+		for i, ch := range line {
+			digits[i] = int(ch - '0') // 1–9
+		}
+
+		d.batteryBanks = append(d.batteryBanks, digits)
+	}
+	return nil
+}
+
 // -------------------------
 // Part 1
 // -------------------------
@@ -11,7 +11,12 @@ type day04 struct {
 }
 
 func init() {
-	Register(4, func() Solution { return &day04{} })
+	RegisterInfo(Info{
+		Day:    4,
+		Title:  "Printing Department",
+		Tags:   []string{"grid", "simulation"},
+		Author: "kjn-void",
+	}, func() Solution { return &day04{} })
 }
 
 // SetInput stores the paper-roll diagram and records its dimensions for the
@@ -27,6 +32,30 @@ func (d *day04) SetInput(lines []string) {
 	d.cols = len(d.grid[0])
 }
 
+// Parse is the strict counterpart of SetInput and reports ragged rows and any
+// cell that is neither '@' nor '.'.
+func (d *day04) Parse(lines []string) error {
+	d.grid = d.grid[:0]
+	d.cols = 0
+
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		if len(d.grid) > 0 && len(line) != d.cols {
+			return parseErrorf(i+1, min(len(line), d.cols)+1, "row is %d cells wide, want %d", len(line), d.cols)
+		}
+		if err := checkAlphabet(i+1, line, "@.", "paper-roll diagram"); err != nil {
+			return err
+		}
+		d.grid = append(d.grid, line)
+		d.cols = len(d.grid[0])
+	}
+
+	d.rows = len(d.grid)
+	return nil
+}
+
 // -----------------------------------------------------------------------------
 // Common helpers
 // -----------------------------------------------------------------------------
@@ -17,7 +17,12 @@ type freshRange struct {
 }
 
 func init() {
-	Register(5, func() Solution { return &day05{} })
+	RegisterInfo(Info{
+		Day:    5,
+		Title:  "Cafeteria",
+		Tags:   []string{"intervals", "sorting"},
+		Author: "kjn-void",
+	}, func() Solution { return &day05{} })
 }
 
 // SetInput parses fresh ingredient ranges and available ingredient IDs, then
@@ -48,6 +53,55 @@ func (d *day05) SetInput(lines []string) {
 		}
 	}
 
+	d.mergeFreshRanges()
+}
+
+// Parse is the strict counterpart of SetInput and reports the first line that
+// is not a range in the first block or an ID in the second.
+func (d *day05) Parse(lines []string) error {
+	d.freshRanges = d.freshRanges[:0]
+	d.ingredientIDs = d.ingredientIDs[:0]
+
+	// Split into two blocks: ranges, blank line, then available IDs
+	section := 0
+	for i, line := range lines {
+		s := lineSpan(line).trim()
+		if s.text == "" {
+			section++
+			continue
+		}
+
+		var err error
+		switch {
+		case section == 0:
+			// fresh ranges
+			var r idRange
+			r, err = parseIDRange(i+1, s)
+			if err == nil {
+				d.freshRanges = append(d.freshRanges, freshRange{start: r.first, end: r.last})
+			}
+		case section == 1:
+			// available ingredient IDs (used only in part 1)
+			var id int64
+			id, err = parseNonNegative(i+1, s, "ingredient ID")
+			if err == nil {
+				d.ingredientIDs = append(d.ingredientIDs, id)
+			}
+		default:
+			err = parseErrorf(i+1, 0, "unexpected third block; want ranges, a blank line, then IDs")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	d.mergeFreshRanges()
+	return nil
+}
+
+// mergeFreshRanges sorts d.freshRanges and merges overlapping ranges for
+// efficient lookup.
+func (d *day05) mergeFreshRanges() {
 	if len(d.freshRanges) == 0 {
 		return
 	}
@@ -11,7 +11,12 @@ type day06 struct {
 }
 
 func init() {
-	Register(6, func() Solution { return &day06{} })
+	RegisterInfo(Info{
+		Day:    6,
+		Title:  "Trash Compactor",
+		Tags:   []string{"parsing", "grid"},
+		Author: "kjn-void",
+	}, func() Solution { return &day06{} })
 }
 
 // SetInput stores the worksheet rows and pads them to equal width so column
@@ -41,6 +46,73 @@ func (d *day06) SetInput(lines []string) {
 	d.cols = maxC
 }
 
+// Parse is the strict counterpart of SetInput. It checks that the number rows
+// hold only digits, that the last row holds only operators, and that every
+// problem has exactly one operator and one number per row.
+func (d *day06) Parse(lines []string) error {
+	d.grid = d.grid[:0]
+
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for _, line := range lines {
+		d.grid = append(d.grid, line)
+	}
+
+	// Normalize row widths so all rows have identical length.
+	maxC := 0
+	for _, row := range d.grid {
+		if len(row) > maxC {
+			maxC = len(row)
+		}
+	}
+	for i := range d.grid {
+		if len(d.grid[i]) < maxC {
+			missing := maxC - len(d.grid[i])
+			d.grid[i] += strings.Repeat(" ", missing)
+		}
+	}
+
+	d.rows = len(d.grid)
+	d.cols = maxC
+
+	return d.validate()
+}
+
+// validate reports the first place where the worksheet does not have the
+// shape both parts rely on.
+func (d *day06) validate() error {
+	if d.rows < 2 {
+		return parseErrorf(d.rows+1, 0, "worksheet needs number rows followed by an operator row")
+	}
+	for r := 0; r < d.rows-1; r++ {
+		if err := checkAlphabet(r+1, d.grid[r], "0123456789 ", "number row"); err != nil {
+			return err
+		}
+	}
+	if err := checkAlphabet(d.rows, d.grid[d.rows-1], "+* ", "operator row"); err != nil {
+		return err
+	}
+
+	for _, problem := range d.findProblems() {
+		for r := 0; r < d.rows-1; r++ {
+			cell := span{text: d.grid[r][problem.start : problem.end+1], col: problem.start + 1}.trim()
+			if cell.text == "" {
+				return parseErrorf(r+1, problem.start+1, "problem has no number on this row")
+			}
+			if i := strings.IndexByte(cell.text, ' '); i >= 0 {
+				return parseErrorf(r+1, cell.col+i, "problem has more than one number on this row")
+			}
+		}
+
+		opRow := d.grid[d.rows-1][problem.start : problem.end+1]
+		if ops := strings.Count(opRow, "+") + strings.Count(opRow, "*"); ops != 1 {
+			return parseErrorf(d.rows, problem.start+1, "problem has %d operators, want 1", ops)
+		}
+	}
+	return nil
+}
+
 // -----------------------------------------------------------
 // Helpers
 // -----------------------------------------------------------
@@ -13,7 +13,12 @@ type day07 struct {
 }
 
 func init() {
-	Register(7, func() Solution { return &day07{} })
+	RegisterInfo(Info{
+		Day:    7,
+		Title:  "Laboratories",
+		Tags:   []string{"grid", "dynamic programming"},
+		Author: "kjn-void",
+	}, func() Solution { return &day07{} })
 }
 
 // SetInput stores the tachyon manifold diagram, normalizes row widths, and
@@ -46,6 +51,76 @@ func (d *day07) SetInput(lines []string) {
 	}
 }
 
+// Parse is the strict counterpart of SetInput. It checks that the first row
+// holds exactly one S, that the rest of the diagram holds only '.' and '^',
+// and that no splitter sits on the edge where a beam would leave the grid.
+func (d *day07) Parse(lines []string) error {
+	d.grid = d.grid[:0]
+	d.rows, d.cols, d.startCol = 0, 0, 0
+
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return parseErrorf(1, 0, "empty diagram")
+	}
+
+	// Keep layout exactly; AoC never gives malformed lines
+	for _, line := range lines {
+		d.grid = append(d.grid, line)
+	}
+
+	// Normalize width so all rows have same length
+	maxC := len(d.grid[0])
+	for i := range d.grid {
+		if len(d.grid[i]) < maxC {
+			d.grid[i] += strings.Repeat(" ", maxC-len(d.grid[i]))
+		}
+	}
+
+	d.rows = len(d.grid)
+	d.cols = maxC
+
+	if err := d.validate(lines); err != nil {
+		return err
+	}
+
+	// Locate S on first row
+	for c := 0; c < d.cols; c++ {
+		if d.grid[0][c] == 'S' {
+			d.startCol = c
+			break
+		}
+	}
+	return nil
+}
+
+// validate checks the diagram as given in lines, before any padding.
+func (d *day07) validate(lines []string) error {
+	if err := checkAlphabet(1, lines[0], ".S", "first row"); err != nil {
+		return err
+	}
+	if n := strings.Count(lines[0], "S"); n != 1 {
+		return parseErrorf(1, 0, "first row has %d start positions, want 1", n)
+	}
+
+	for r, line := range lines[1:] {
+		if len(line) != d.cols {
+			return parseErrorf(r+2, min(len(line), d.cols)+1, "row is %d cells wide, want %d", len(line), d.cols)
+		}
+		if err := checkAlphabet(r+2, line, ".^", "manifold row"); err != nil {
+			return err
+		}
+		if line[0] == '^' {
+			return parseErrorf(r+2, 1, "splitter on the left edge")
+		}
+		if line[len(line)-1] == '^' {
+			return parseErrorf(r+2, len(line), "splitter on the right edge")
+		}
+	}
+	return nil
+}
+
 // -----------------------------------------------------------
 // Part 1 — Linear beam simulation counting split events
 // -----------------------------------------------------------
@@ -22,7 +22,12 @@ type connection struct {
 }
 
 func init() {
-	Register(8, func() Solution { return &day08{} })
+	RegisterInfo(Info{
+		Day:    8,
+		Title:  "Playground",
+		Tags:   []string{"graph", "union-find"},
+		Author: "kjn-void",
+	}, func() Solution { return &day08{} })
 }
 
 // -----------------------------------------------------------
@@ -39,6 +44,25 @@ func parseVec3(line string) vec3 {
 	return vec3{x, y, z}
 }
 
+// parseVec3Strict is parseVec3 for Parse: it reports a malformed coordinate
+// line instead of reading it as zeros.
+func parseVec3Strict(lineNo int, s span) (vec3, error) {
+	parts := s.split(",")
+	if len(parts) != 3 {
+		return vec3{}, parseErrorf(lineNo, s.col, "want X,Y,Z coordinates, got %d values", len(parts))
+	}
+
+	var coords [3]int64
+	for i, what := range []string{"X coordinate", "Y coordinate", "Z coordinate"} {
+		v, err := parseInt(lineNo, parts[i], what)
+		if err != nil {
+			return vec3{}, err
+		}
+		coords[i] = v
+	}
+	return vec3{coords[0], coords[1], coords[2]}, nil
+}
+
 // SetInput parses junction-box coordinates and precomputes all sorted pairwise
 // connections for the circuit-building algorithms.
 func (d *day08) SetInput(lines []string) {
@@ -56,6 +80,28 @@ func (d *day08) SetInput(lines []string) {
 	d.connections = buildSortedConnections(d.junctionBoxes)
 }
 
+// Parse is the strict counterpart of SetInput and reports the first line that
+// is not an X,Y,Z coordinate.
+func (d *day08) Parse(lines []string) error {
+	d.junctionBoxes = d.junctionBoxes[:0]
+
+	for i, ln := range lines {
+		s := lineSpan(ln).trim()
+		if s.text == "" {
+			continue
+		}
+		p, err := parseVec3Strict(i+1, s)
+		if err != nil {
+			return err
+		}
+		d.junctionBoxes = append(d.junctionBoxes, p)
+	}
+
+	// Build and sort all pairwise connections once; reuse in both parts.
+	d.connections = buildSortedConnections(d.junctionBoxes)
+	return nil
+}
+
 // -----------------------------------------------------------
 // Distance & Edge Preparation
 // -----------------------------------------------------------
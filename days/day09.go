@@ -22,7 +22,12 @@ type edge9 struct {
 }
 
 func init() {
-	Register(9, func() Solution { return &day09{} })
+	RegisterInfo(Info{
+		Day:    9,
+		Title:  "Movie Theater",
+		Tags:   []string{"geometry", "polygon"},
+		Author: "kjn-void",
+	}, func() Solution { return &day09{} })
 }
 
 // SetInput parses red tile coordinates and clears derived polygon edge caches.
@@ -43,6 +48,48 @@ func (d *day09) SetInput(lines []string) {
 	d.horEdges = nil
 }
 
+// Parse is the strict counterpart of SetInput and reports the first line that
+// is not an X,Y tile coordinate.
+func (d *day09) Parse(lines []string) error {
+	d.reds = d.reds[:0]
+	for i, line := range lines {
+		s := lineSpan(line).trim()
+		if s.text == "" {
+			continue
+		}
+		p, err := parseTile(i+1, s)
+		if err != nil {
+			return err
+		}
+		d.reds = append(d.reds, p)
+	}
+	d.edges = nil
+	d.vertEdges = nil
+	d.horEdges = nil
+	return nil
+}
+
+// parseTile parses one "X,Y" red tile coordinate.
+func parseTile(lineNo int, s span) (pt9, error) {
+	x, y, ok := s.cut(",")
+	if !ok {
+		return pt9{}, parseErrorf(lineNo, s.col, "want X,Y coordinates, got %q", s.text)
+	}
+	if i := strings.IndexByte(y.text, ','); i >= 0 {
+		return pt9{}, parseErrorf(lineNo, y.col+i, "want X,Y coordinates, got more than two values")
+	}
+
+	xv, err := parseInt(lineNo, x, "X coordinate")
+	if err != nil {
+		return pt9{}, err
+	}
+	yv, err := parseInt(lineNo, y, "Y coordinate")
+	if err != nil {
+		return pt9{}, err
+	}
+	return pt9{int(xv), int(yv)}, nil
+}
+
 // ----------------------------------------------------------
 // Part 1 - largest rectangle from any two red tiles
 // ----------------------------------------------------------
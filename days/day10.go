@@ -1,6 +1,7 @@
 package days
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strconv"
@@ -18,7 +19,12 @@ type day10 struct {
 }
 
 func init() {
-	Register(10, func() Solution { return &day10{} })
+	RegisterInfo(Info{
+		Day:    10,
+		Title:  "Factory",
+		Tags:   []string{"ILP", "linear algebra"},
+		Author: "kjn-void",
+	}, func() Solution { return &day10{} })
 }
 
 // ------------------------------------------------------------
@@ -49,7 +55,8 @@ func parseList(s string) []int {
 }
 
 // SetInput parses each machine manual line into target indicator lights,
-// button wiring, and joltage requirements for the two solvers.
+// button wiring, and joltage requirements for the two solvers. Lines that are
+// not machine descriptions are skipped.
 func (d *day10) SetInput(lines []string) {
 	d.machines = d.machines[:0]
 
@@ -59,58 +66,180 @@ func (d *day10) SetInput(lines []string) {
 			continue
 		}
 
-		// Format: [.##.] (3) (1,3) ... {3,5,4,7}
+		m, err := parseMachine(line)
+		if err != nil {
+			continue
+		}
+		d.machines = append(d.machines, m)
+	}
+}
 
-		// 1. Extract lights [ ... ]
-		startBracket := strings.Index(line, "[")
-		endBracket := strings.Index(line, "]")
-		if startBracket == -1 || endBracket == -1 {
+// Parse is the strict counterpart of SetInput and reports the first line that
+// does not describe a machine.
+func (d *day10) Parse(lines []string) error {
+	d.machines = d.machines[:0]
+
+	for i, line := range lines {
+		s := lineSpan(line).trim()
+		if s.text == "" {
 			continue
 		}
-		lightStr := line[startBracket+1 : endBracket]
-		lights := make([]int, len(lightStr))
-		for i, char := range lightStr {
-			if char == '#' {
-				lights[i] = 1
-			} else {
-				lights[i] = 0
-			}
+
+		m, err := parseMachineStrict(i+1, s)
+		if err != nil {
+			return err
 		}
+		d.machines = append(d.machines, m)
+	}
+	return nil
+}
 
-		// 2. Extract joltage { ... }
-		startBrace := strings.Index(line, "{")
-		endBrace := strings.Index(line, "}")
-		var joltage []int
-		if startBrace != -1 && endBrace != -1 {
-			joltage = parseList(line[startBrace : endBrace+1])
+// parseMachine parses one manual line of the form
+// "[.##.] (3) (1,3) ... {3,5,4,7}" into a machine.
+func parseMachine(line string) (machine, error) {
+	// 1. Extract lights [ ... ]
+	startBracket := strings.Index(line, "[")
+	endBracket := strings.Index(line, "]")
+	if startBracket == -1 || endBracket == -1 {
+		return machine{}, fmt.Errorf("missing [lights] section")
+	}
+	lightStr := line[startBracket+1 : endBracket]
+	lights := make([]int, len(lightStr))
+	for i, char := range lightStr {
+		if char == '#' {
+			lights[i] = 1
+		} else {
+			lights[i] = 0
 		}
+	}
+
+	// 2. Extract joltage { ... }
+	startBrace := strings.Index(line, "{")
+	endBrace := strings.Index(line, "}")
+	var joltage []int
+	if startBrace != -1 && endBrace != -1 {
+		joltage = parseList(line[startBrace : endBrace+1])
+	}
+
+	// 3. Extract buttons (...) between ']' and '{' (if present)
+	midSection := line[endBracket+1:]
+	if startBrace != -1 {
+		midSection = line[endBracket+1 : startBrace]
+	}
 
-		// 3. Extract buttons (...) between ']' and '{' (if present)
-		midSection := line[endBracket+1:]
-		if startBrace != -1 {
-			midSection = line[endBracket+1 : startBrace]
+	buttons := make([][]int, 0)
+	for {
+		pStart := strings.Index(midSection, "(")
+		if pStart == -1 {
+			break
+		}
+		pEnd := strings.Index(midSection, ")")
+		if pEnd == -1 {
+			break
 		}
+		buttons = append(buttons, parseList(midSection[pStart:pEnd+1]))
+		midSection = midSection[pEnd+1:]
+	}
 
-		buttons := make([][]int, 0)
-		for {
-			pStart := strings.Index(midSection, "(")
-			if pStart == -1 {
-				break
-			}
-			pEnd := strings.Index(midSection, ")")
-			if pEnd == -1 {
-				break
-			}
-			buttons = append(buttons, parseList(midSection[pStart:pEnd+1]))
-			midSection = midSection[pEnd+1:]
+	return machine{
+		targetLights:  lights,
+		targetJoltage: joltage,
+		buttons:       buttons,
+	}, nil
+}
+
+// parseListStrict is the strict form of parseList: it parses a bracketed,
+// parenthesized, or braced comma-separated list of integers and reports the
+// first value that is not a non-negative integer.
+func parseListStrict(lineNo int, s span) ([]int, error) {
+	s = s.trim()
+	if len(s.text) < 2 {
+		return nil, parseErrorf(lineNo, s.col, "list %q is too short", s.text)
+	}
+	// Remove outer brackets/parens/braces
+	inner := span{text: s.text[1 : len(s.text)-1], col: s.col + 1}
+	if inner.trim().text == "" {
+		return nil, nil
+	}
+	parts := inner.split(",")
+	result := make([]int, 0, len(parts))
+	for _, p := range parts {
+		val, err := parseNonNegative(lineNo, p, "list value")
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, int(val))
+	}
+	return result, nil
+}
+
+// parseMachineStrict is the strict form of parseMachine. Besides the
+// [lights] section it requires a {joltage} section with one value per light,
+// at the end of the line, and buttons that only wire existing lights.
+func parseMachineStrict(lineNo int, s span) (machine, error) {
+	line := s.text
+
+	// 1. Extract lights [ ... ]
+	startBracket := strings.Index(line, "[")
+	endBracket := strings.Index(line, "]")
+	if startBracket != 0 || endBracket == -1 {
+		return machine{}, parseErrorf(lineNo, s.col, "missing [lights] section")
+	}
+	lightStr := line[startBracket+1 : endBracket]
+	lights := make([]int, len(lightStr))
+	for i, char := range lightStr {
+		switch char {
+		case '#':
+			lights[i] = 1
+		case '.':
+			lights[i] = 0
+		default:
+			return machine{}, parseErrorf(lineNo, s.col+startBracket+1+i, "unexpected %q in lights", char)
 		}
+	}
 
-		d.machines = append(d.machines, machine{
-			targetLights:  lights,
-			targetJoltage: joltage,
-			buttons:       buttons,
-		})
+	// 2. Extract joltage { ... }
+	startBrace := strings.Index(line, "{")
+	endBrace := strings.Index(line, "}")
+	if startBrace == -1 || endBrace < startBrace {
+		return machine{}, parseErrorf(lineNo, s.col+len(line), "missing {joltage} section")
+	}
+	if endBrace != len(line)-1 {
+		return machine{}, parseErrorf(lineNo, s.col+endBrace+1, "unexpected text after {joltage} section")
 	}
+	joltage, err := parseListStrict(lineNo, span{text: line[startBrace : endBrace+1], col: s.col + startBrace})
+	if err != nil {
+		return machine{}, err
+	}
+	if len(joltage) != len(lights) {
+		return machine{}, parseErrorf(lineNo, s.col+startBrace, "%d joltage values for %d lights", len(joltage), len(lights))
+	}
+
+	// 3. Extract buttons (...) between ']' and '{'
+	mid := span{text: line[endBracket+1 : startBrace], col: s.col + endBracket + 1}
+
+	buttons := make([][]int, 0)
+	for _, field := range mid.fields() {
+		if field.text[0] != '(' || field.text[len(field.text)-1] != ')' {
+			return machine{}, parseErrorf(lineNo, field.col, "button %q is not a (list)", field.text)
+		}
+		button, err := parseListStrict(lineNo, field)
+		if err != nil {
+			return machine{}, err
+		}
+		for _, idx := range button {
+			if idx >= len(lights) {
+				return machine{}, parseErrorf(lineNo, field.col, "button wires light %d but there are only %d lights", idx, len(lights))
+			}
+		}
+		buttons = append(buttons, button)
+	}
+
+	return machine{
+		targetLights:  lights,
+		targetJoltage: joltage,
+		buttons:       buttons,
+	}, nil
 }
 
 // ------------------------------------------------------------
@@ -429,37 +558,71 @@ func solveJoltageRequirements(m machine) (int, error) {
 // SolvePart1 sums the minimum indicator-light button presses across all parsed
 // machines and returns the total.
 func (d *day10) SolvePart1() string {
+	res, err := d.Part1(context.Background())
+	if err != nil {
+		// With AoC input we expect solutions; panic if not.
+		panic(err.Error())
+	}
+	return res
+}
+
+// SolvePart2 solves each machine's joltage system concurrently, sums the minimum
+// press counts, and returns the total.
+func (d *day10) SolvePart2() string {
+	res, err := d.Part2(context.Background())
+	if err != nil {
+		panic(err.Error())
+	}
+	return res
+}
+
+// Part1 is the error-returning form of SolvePart1. It reports the first
+// machine whose lights cannot be configured and checks ctx between machines.
+func (d *day10) Part1(ctx context.Context) (string, error) {
 	total := 0
-	for _, m := range d.machines {
+	for i, m := range d.machines {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
 		if len(m.targetLights) == 0 {
 			continue
 		}
 		res, err := solveIndicatorLights(m)
 		if err != nil {
-			// With AoC input we expect solutions; panic if not.
-			panic(fmt.Sprintf("Day10 Part1: %v", err))
+			return "", fmt.Errorf("Day10 Part1: machine %d: %w", i+1, err)
 		}
 		total += res
 	}
-	return strconv.Itoa(total)
+	return strconv.Itoa(total), nil
 }
 
-// SolvePart2 solves each machine's joltage system concurrently, sums the minimum
-// press counts, and returns the total.
-func (d *day10) SolvePart2() string {
+// Part2 is the error-returning form of SolvePart2. Machines are still solved
+// concurrently; the first failure or a cancelled ctx ends the wait.
+func (d *day10) Part2(ctx context.Context) (string, error) {
+	type machineResult struct {
+		index int
+		res   int
+		err   error
+	}
+
 	total := 0
-	resultCh := make(chan int, len(d.machines))
-	for _, m := range d.machines {
-		go func(m machine) {
+	resultCh := make(chan machineResult, len(d.machines))
+	for i, m := range d.machines {
+		go func(i int, m machine) {
 			res, err := solveJoltageRequirements(m)
-			if err != nil {
-				panic(fmt.Sprintf("Day10 Part2: %v", err))
-			}
-			resultCh <- res
-		}(m)
+			resultCh <- machineResult{index: i, res: res, err: err}
+		}(i, m)
 	}
 	for range d.machines {
-		total += <-resultCh
+		select {
+		case r := <-resultCh:
+			if r.err != nil {
+				return "", fmt.Errorf("Day10 Part2: machine %d: %w", r.index+1, r.err)
+			}
+			total += r.res
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
 	}
-	return strconv.Itoa(total)
+	return strconv.Itoa(total), nil
 }
@@ -69,3 +69,11 @@ func splitLines(s string) []string {
 func BenchmarkDay10(b *testing.B) {
 	benchmarkDay(b, 10, func() Solution { return &day10{} })
 }
+
+func TestDay10_ParseRejectsMalformedLine(t *testing.T) {
+	var d day10
+	err := d.Parse([]string{"[#] (0) {5}", "(0) {5}"})
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("Parse: got %v, want error mentioning line 2", err)
+	}
+}
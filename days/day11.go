@@ -10,7 +10,12 @@ type day11 struct {
 }
 
 func init() {
-	Register(11, func() Solution { return &day11{} })
+	RegisterInfo(Info{
+		Day:    11,
+		Title:  "Reactor",
+		Tags:   []string{"graph", "memoization"},
+		Author: "kjn-void",
+	}, func() Solution { return &day11{} })
 }
 
 // SetInput parses device output lines into a directed graph from each device to
@@ -44,6 +49,43 @@ func (d *day11) SetInput(lines []string) {
 	}
 }
 
+// Parse is the strict counterpart of SetInput and reports lines without a
+// "device:" prefix and devices listed twice.
+func (d *day11) Parse(lines []string) error {
+	d.outputs = make(map[string][]string)
+
+	for i, line := range lines {
+		s := lineSpan(line).trim()
+		if s.text == "" {
+			continue
+		}
+
+		// Format: "aaa: you hhh"
+		name, right, found := s.cut(":")
+		name = name.trim()
+		from := name.text
+
+		switch {
+		case !found:
+			return parseErrorf(i+1, s.col+len(s.text), "missing ':' after device name")
+		case from == "":
+			return parseErrorf(i+1, name.col, "missing device name")
+		case strings.ContainsAny(from, " \t"):
+			return parseErrorf(i+1, name.col, "device name %q contains spaces", from)
+		}
+		if _, dup := d.outputs[from]; dup {
+			return parseErrorf(i+1, name.col, "device %q is listed twice", from)
+		}
+
+		var outs []string
+		for _, tok := range right.fields() {
+			outs = append(outs, tok.text)
+		}
+		d.outputs[from] = outs
+	}
+	return nil
+}
+
 // -----------------------------------------------------------
 // Part 1 — count all paths from "you" to "out"
 // -----------------------------------------------------------
@@ -32,7 +32,13 @@ type day12 struct {
 }
 
 func init() {
-	Register(12, func() Solution { return &day12{} })
+	RegisterInfo(Info{
+		Day:          12,
+		Title:        "Christmas Tree Farm",
+		Tags:         []string{"grid", "packing", "backtracking"},
+		Author:       "kjn-void",
+		Part2Missing: true,
+	}, func() Solution { return &day12{} })
 }
 
 // --- Parsing ---------------------------------------------------------------
@@ -141,6 +147,135 @@ func (d *day12) SetInput(lines []string) {
 	}
 }
 
+// Parse is the strict counterpart of SetInput. It reports shape headers out of
+// order, shape rows with anything but '#' and '.', and region lines that are
+// malformed or list a different number of counts than there are shapes.
+func (d *day12) Parse(lines []string) error {
+	d.shapes = d.shapes[:0]
+	d.regions = d.regions[:0]
+
+	// Parse shapes first, then regions.
+	i := 0
+	for i < len(lines) && strings.TrimSpace(lines[i]) == "" {
+		i++
+	}
+
+	// --- Parse shape blocks of form:
+	// 0:
+	// ###
+	// ..#
+	// ###
+	for i < len(lines) {
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			i++
+			continue
+		}
+		if isRegionLine(line) {
+			// We've reached the region section.
+			break
+		}
+
+		// Expect a header like "0:" or "5:"
+		if !strings.HasSuffix(line, ":") {
+			return parseErrorf(i+1, 0, "expected a shape header like \"%d:\", got %q", len(d.shapes), line)
+		}
+		header := lineSpan(lines[i]).trim()
+		index, err := parseNonNegative(i+1, span{text: strings.TrimSuffix(header.text, ":"), col: header.col}, "shape index")
+		if err != nil {
+			return err
+		}
+		if int(index) != len(d.shapes) {
+			return parseErrorf(i+1, header.col, "shape %d is out of order, want %d", index, len(d.shapes))
+		}
+		headerLine := i + 1
+		i++
+
+		var rows []string
+		for i < len(lines) {
+			s := strings.TrimRight(strings.TrimRight(lines[i], "\r"), "\n")
+			if strings.TrimSpace(s) == "" {
+				i++
+				break
+			}
+			trimmed := strings.TrimSpace(s)
+
+			// Stop if we hit the next shape header or a region line.
+			if strings.HasSuffix(trimmed, ":") || isRegionLine(trimmed) {
+				break
+			}
+			if err := checkAlphabet(i+1, s, "#.", "shape row"); err != nil {
+				return err
+			}
+			rows = append(rows, trimmed)
+			i++
+		}
+		if len(rows) > 0 {
+			d.shapes = append(d.shapes, buildShape(rows))
+		} else {
+			return parseErrorf(headerLine, 0, "shape has no rows")
+		}
+	}
+
+	// --- Parse regions: "WxH: c0 c1 c2 ..."
+	for ; i < len(lines); i++ {
+		s := lineSpan(lines[i]).trim()
+		if s.text == "" {
+			continue
+		}
+		r, err := parseRegion(i+1, s, len(d.shapes))
+		if err != nil {
+			return err
+		}
+		d.regions = append(d.regions, r)
+	}
+	return nil
+}
+
+// parseRegion parses one "WxH: c0 c1 ..." region line listing how many of
+// each of the shapes must fit into it.
+func parseRegion(lineNo int, s span, shapes int) (region, error) {
+	dims, counts, ok := s.cut(":")
+	if !ok {
+		return region{}, parseErrorf(lineNo, s.col, "expected a region like \"12x5: 1 0 2\", got %q", s.text)
+	}
+
+	w, h, ok := dims.cut("x")
+	if !ok {
+		return region{}, parseErrorf(lineNo, dims.col, "region size %q is not WxH", dims.text)
+	}
+	width, err := parseNonNegative(lineNo, w, "region width")
+	if err != nil {
+		return region{}, err
+	}
+	height, err := parseNonNegative(lineNo, h, "region height")
+	if err != nil {
+		return region{}, err
+	}
+
+	countFields := counts.fields()
+	if len(countFields) == 0 {
+		return region{}, parseErrorf(lineNo, counts.col, "region lists no present counts")
+	}
+	if len(countFields) != shapes {
+		return region{}, parseErrorf(lineNo, counts.col, "region lists %d present counts for %d shapes", len(countFields), shapes)
+	}
+	values := make([]int, len(countFields))
+	for idx, f := range countFields {
+		val, err := parseNonNegative(lineNo, f, "present count")
+		if err != nil {
+			return region{}, err
+		}
+		values[idx] = int(val)
+	}
+
+	return region{
+		width:  int(width),
+		height: int(height),
+		counts: values,
+	}, nil
+}
+
 // isRegionLine reports whether s has the region header form "WxH: ..." and can
 // therefore mark the start of the region section.
 func isRegionLine(s string) bool {
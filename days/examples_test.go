@@ -0,0 +1,90 @@
+package days
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"aoc2025/puzzleinput"
+)
+
+// TestExamples runs every testdata/YYYY/dayNN/example*.in file through the
+// solver registered for that year and day, parsing strictly as the CLI does,
+// and compares the answers listed in the matching .out file ("part1: X" /
+// "part2: Y" lines). The files are written by "aoc2025 examples". Examples for
+// other years are skipped when their solvers are not linked into this test.
+func TestExamples(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*", "day*", "example*.in"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, inPath := range inputs {
+		dayDir := filepath.Dir(inPath)
+		var year, day int
+		if _, err := fmt.Sscanf(filepath.Base(dayDir), "day%d", &day); err != nil {
+			t.Errorf("%s: cannot determine day: %v", inPath, err)
+			continue
+		}
+		if _, err := fmt.Sscanf(filepath.Base(filepath.Dir(dayDir)), "%d", &year); err != nil {
+			t.Errorf("%s: cannot determine year: %v", inPath, err)
+			continue
+		}
+
+		name := strings.TrimSuffix(filepath.ToSlash(inPath), ".in")
+		t.Run(strings.TrimPrefix(name, "testdata/"), func(t *testing.T) {
+			input, err := puzzleinput.ReadFile(inPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := readExpectedAnswers(strings.TrimSuffix(inPath, ".in") + ".out")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s, ok := GetSolverYear(year, day)
+			if !ok && year != DefaultYear {
+				t.Skipf("no solver for %d day %d in this package", year, day)
+			}
+			if !ok {
+				t.Fatalf("no solver registered for day %d", day)
+			}
+			if err := s.Parse(input); err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+
+			if answer, ok := want["part1"]; ok {
+				if got, err := s.Part1(context.Background()); err != nil || got != answer {
+					t.Errorf("Part1: got %s, %v; want %s", got, err, answer)
+				}
+			}
+			if answer, ok := want["part2"]; ok {
+				if got, err := s.Part2(context.Background()); err != nil || got != answer {
+					t.Errorf("Part2: got %s, %v; want %s", got, err, answer)
+				}
+			}
+		})
+	}
+}
+
+// readExpectedAnswers parses an example .out file into answers keyed by
+// "part1" and "part2".
+func readExpectedAnswers(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	answers := make(map[string]string)
+	for _, line := range strings.Split(string(data), "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		answers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return answers, nil
+}
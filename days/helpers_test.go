@@ -4,22 +4,55 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
+	"strconv"
 	"testing"
+
+	"aoc2025/inputcrypt"
+	"aoc2025/puzzleinput"
 )
 
-// loadRealInput reads input/dayNN.txt for benchmarks and returns its lines,
-// preserving any meaningful blank lines inside the file.
+// loadRealInput reads input/YYYY/dayNN.txt (or the older input/dayNN.txt) for
+// benchmarks and returns its lines, normalized the same way as the CLI does.
+// Like the CLI it honours AOC_INPUT_DIR and, when only dayNN.txt.enc is
+// present, decrypts it with AOC_INPUT_PASSPHRASE.
 func loadRealInput(b *testing.B, day int) []string {
 	b.Helper()
 
-	path := filepath.Join("..", "input", fmt.Sprintf("day%02d.txt", day))
-	data, err := os.ReadFile(path)
+	dir := os.Getenv("AOC_INPUT_DIR")
+	if dir == "" {
+		dir = filepath.Join("..", "input")
+	}
+
+	path := filepath.Join(dir, strconv.Itoa(DefaultYear), fmt.Sprintf("day%02d.txt", day))
+	lines, err := puzzleinput.ReadFile(path)
+	if os.IsNotExist(err) {
+		lines, err = loadEncryptedInput(path+".enc", day)
+	}
+	if os.IsNotExist(err) {
+		lines, err = puzzleinput.ReadFile(filepath.Join(dir, fmt.Sprintf("day%02d.txt", day)))
+	}
 	if err != nil {
 		b.Fatalf("Missing input file: %v", err)
 	}
 
-	return strings.Split(strings.TrimRight(string(data), "\r\n"), "\n")
+	return lines
+}
+
+// loadEncryptedInput decrypts an input written by "aoc2025 inputs encrypt".
+func loadEncryptedInput(path string, day int) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	passphrase := os.Getenv("AOC_INPUT_PASSPHRASE")
+	if passphrase == "" {
+		return nil, fmt.Errorf("%s is encrypted; set AOC_INPUT_PASSPHRASE to read it", path)
+	}
+	plain, err := inputcrypt.Open(passphrase, data, inputcrypt.Label(DefaultYear, day))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return puzzleinput.Split(plain), nil
 }
 
 // benchmarkDay runs the standard Advent of Code benchmark suite for one day:
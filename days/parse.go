@@ -0,0 +1,137 @@
+package days
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseError reports malformed puzzle input found by a strict Parse. Line and
+// Column are 1-based; Column is 0 when the problem concerns the line as a
+// whole, such as a missing line or a duplicate entry.
+type ParseError struct {
+	Line   int
+	Column int
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Reason)
+	}
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Reason)
+}
+
+// parseErrorf returns a *ParseError for line and column with a formatted
+// reason.
+func parseErrorf(line, column int, format string, args ...any) *ParseError {
+	return &ParseError{Line: line, Column: column, Reason: fmt.Sprintf(format, args...)}
+}
+
+// span is a piece of an input line together with the 1-based column it
+// starts at, so errors about a field can point at the field itself.
+type span struct {
+	text string
+	col  int
+}
+
+// lineSpan returns the whole of line as a span starting at column 1.
+func lineSpan(line string) span {
+	return span{text: line, col: 1}
+}
+
+// trim strips surrounding spaces and tabs, keeping the column in step.
+func (s span) trim() span {
+	left := strings.TrimLeft(s.text, " \t")
+	return span{text: strings.TrimRight(left, " \t"), col: s.col + len(s.text) - len(left)}
+}
+
+// split cuts s around every occurrence of sep, like strings.Split.
+func (s span) split(sep string) []span {
+	parts := strings.Split(s.text, sep)
+	spans := make([]span, len(parts))
+	col := s.col
+	for i, part := range parts {
+		spans[i] = span{text: part, col: col}
+		col += len(part) + len(sep)
+	}
+	return spans
+}
+
+// cut slices s around the first sep, like strings.Cut.
+func (s span) cut(sep string) (before, after span, found bool) {
+	i := strings.Index(s.text, sep)
+	if i < 0 {
+		return s, span{col: s.col + len(s.text)}, false
+	}
+	return span{text: s.text[:i], col: s.col},
+		span{text: s.text[i+len(sep):], col: s.col + i + len(sep)},
+		true
+}
+
+// fields splits s around runs of spaces and tabs, like strings.Fields.
+func (s span) fields() []span {
+	var spans []span
+	start := -1
+	for i := 0; i <= len(s.text); i++ {
+		blank := i == len(s.text) || s.text[i] == ' ' || s.text[i] == '\t'
+		switch {
+		case !blank && start < 0:
+			start = i
+		case blank && start >= 0:
+			spans = append(spans, span{text: s.text[start:i], col: s.col + start})
+			start = -1
+		}
+	}
+	return spans
+}
+
+// parseInt parses s as a base-10 integer on line; what names the value in the
+// error, e.g. "x coordinate".
+func parseInt(line int, s span, what string) (int64, error) {
+	s = s.trim()
+	if s.text == "" {
+		return 0, parseErrorf(line, s.col, "missing %s", what)
+	}
+	n, err := strconv.ParseInt(s.text, 10, 64)
+	if err != nil {
+		if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+			return 0, parseErrorf(line, s.col, "%s %s is out of range", what, s.text)
+		}
+		return 0, parseErrorf(line, s.col+badDigit(s.text), "invalid %s %q", what, s.text)
+	}
+	return n, nil
+}
+
+// parseNonNegative is parseInt for values that may not be negative.
+func parseNonNegative(line int, s span, what string) (int64, error) {
+	n, err := parseInt(line, s, what)
+	if err == nil && n < 0 {
+		return 0, parseErrorf(line, s.trim().col, "%s must not be negative, got %d", what, n)
+	}
+	return n, err
+}
+
+// badDigit returns the offset of the first byte of s that cannot be part of a
+// decimal integer, or 0 when the whole value is at fault.
+func badDigit(s string) int {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' || i == 0 && (c == '-' || c == '+') && len(s) > 1 {
+			continue
+		}
+		return i
+	}
+	return 0
+}
+
+// checkAlphabet returns an error for the first byte of line that is not in
+// allowed.
+func checkAlphabet(lineNo int, line, allowed, what string) error {
+	for i := 0; i < len(line); i++ {
+		if strings.IndexByte(allowed, line[i]) < 0 {
+			return parseErrorf(lineNo, i+1, "unexpected %q in %s", line[i], what)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package days
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// strictExamples returns the puzzle example of every day, which Parse must
+// accept.
+func strictExamples() map[int][]string {
+	return map[int][]string{
+		1:  exampleDay01,
+		2:  day02ExampleInput,
+		3:  day03ExampleInput,
+		4:  day04ExampleInput,
+		5:  day05ExampleInput,
+		6:  day06ExampleInput,
+		7:  day07ExampleInput,
+		8:  exampleDay08,
+		9:  exampleDay09,
+		10: splitLines(day10Example),
+		11: splitLines(day11ExamplePart2),
+		12: splitLines(day12Example),
+	}
+}
+
+func TestStrictParseAcceptsExamples(t *testing.T) {
+	for day, lines := range strictExamples() {
+		s, ok := GetSolver(day)
+		if !ok {
+			t.Fatalf("no solver registered for day %d", day)
+		}
+		if err := s.Parse(lines); err != nil {
+			t.Errorf("day %d: Parse rejected the puzzle example: %v", day, err)
+		}
+	}
+}
+
+// Each day keeps two parsers: the lenient SetInput and the strict Parse. On
+// well-formed input they must agree, or the CLI and the benchmarks would be
+// solving different puzzles.
+func TestParseMatchesSetInput(t *testing.T) {
+	for day, lines := range strictExamples() {
+		lenient, _ := Get(day)
+		strict, _ := Get(day)
+		lenient.SetInput(lines)
+		if err := strict.(strictParser).Parse(lines); err != nil {
+			t.Fatalf("day %d: Parse: %v", day, err)
+		}
+
+		if got, want := strict.SolvePart1(), lenient.SolvePart1(); got != want {
+			t.Errorf("day %d part 1: %q after Parse, %q after SetInput", day, got, want)
+		}
+		if got, want := strict.SolvePart2(), lenient.SolvePart2(); got != want {
+			t.Errorf("day %d part 2: %q after Parse, %q after SetInput", day, got, want)
+		}
+	}
+}
+
+func TestStrictParseReportsPosition(t *testing.T) {
+	tests := []struct {
+		name  string
+		day   int
+		lines []string
+		want  string
+	}{
+		{"day01 direction", 1, []string{"L68", "X30"}, `line 2, column 1: rotation must start with L or R, got 'X'`},
+		{"day01 distance", 1, []string{"R4x"}, `line 1, column 3: invalid rotation distance "4x"`},
+		{"day01 missing distance", 1, []string{"  L"}, `line 1, column 4: missing rotation distance`},
+		{"day02 missing dash", 2, []string{"11-22,95"}, `line 1, column 7: range "95" is missing '-'`},
+		{"day02 reversed", 2, []string{"11-22,99-95"}, `line 1, column 7: range start 99 is after its end 95`},
+		{"day02 extra line", 2, []string{"11-22", "33-44"}, `line 2: unexpected input after the line of ranges`},
+		{"day03 letter", 3, []string{"987654321", "12a4"}, `line 2, column 3: unexpected 'a' in battery bank`},
+		{"day04 ragged", 4, []string{"@.@", "@."}, `line 2, column 3: row is 2 cells wide, want 3`},
+		{"day04 cell", 4, []string{"@.@", "@x@"}, `line 2, column 2: unexpected 'x' in paper-roll diagram`},
+		{"day05 range", 5, []string{"3-5", "10-x"}, `line 2, column 4: invalid range end "x"`},
+		{"day05 id", 5, []string{"3-5", "", "1", "-2"}, `line 4, column 1: ingredient ID must not be negative, got -2`},
+		{"day05 third block", 5, []string{"3-5", "", "1", "", "2"}, `line 5: unexpected third block; want ranges, a blank line, then IDs`},
+		{"day06 operator", 6, []string{"12 3", " 4 5", "*  -"}, `line 3, column 4: unexpected '-' in operator row`},
+		{"day06 missing operator", 6, []string{"12 3", " 4 5", "*   "}, `line 3, column 4: problem has 0 operators, want 1`},
+		{"day07 start", 7, []string{"...", ".^."}, `line 1: first row has 0 start positions, want 1`},
+		{"day07 edge", 7, []string{".S.", "..^"}, `line 2, column 3: splitter on the right edge`},
+		{"day08 count", 8, []string{"1,2,3", "4,5"}, `line 2, column 1: want X,Y,Z coordinates, got 2 values`},
+		{"day08 value", 8, []string{"1,2,3", "4,y,6"}, `line 2, column 3: invalid Y coordinate "y"`},
+		{"day09 separator", 9, []string{"7,1", "11 1"}, `line 2, column 1: want X,Y coordinates, got "11 1"`},
+		{"day09 value", 9, []string{"7,1", "11,1a"}, `line 2, column 5: invalid Y coordinate "1a"`},
+		{"day10 list", 10, []string{"[.#] (0,x) {1,2}"}, `line 1, column 9: invalid list value "x"`},
+		{"day10 button", 10, []string{"[.#] (0,2) {1,2}"}, `line 1, column 6: button wires light 2 but there are only 2 lights`},
+		{"day10 joltage", 10, []string{"[.#] (0,1)"}, `line 1, column 11: missing {joltage} section`},
+		{"day11 colon", 11, []string{"you: out", "aaa bbb"}, `line 2, column 8: missing ':' after device name`},
+		{"day11 duplicate", 11, []string{"you: out", "you: aaa"}, `line 2, column 1: device "you" is listed twice`},
+		{"day12 shape row", 12, []string{"0:", "#x#", "", "4x4: 1"}, `line 2, column 2: unexpected 'x' in shape row`},
+		{"day12 header order", 12, []string{"1:", "###"}, `line 1, column 1: shape 1 is out of order, want 0`},
+		{"day12 counts", 12, []string{"0:", "##", "", "4x4: 1 2"}, `line 4, column 5: region lists 2 present counts for 1 shapes`},
+		{"day12 region", 12, []string{"0:", "##", "", "4x4: 1", "4y4: 1"}, `line 5, column 1: region size "4y4" is not WxH`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ok := GetSolver(tt.day)
+			if !ok {
+				t.Fatalf("no solver registered for day %d", tt.day)
+			}
+
+			err := s.Parse(tt.lines)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse: got %v, want a *ParseError", err)
+			}
+			if err.Error() != tt.want {
+				t.Fatalf("Parse error:\n got %s\nwant %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetInputKeepsLenientBehaviour(t *testing.T) {
+	// SetInput reads malformed lines as it always has; only Parse rejects
+	// them.
+	var d day01
+	d.SetInput([]string{"L68", "X30", "R4x", "R8"})
+	if want := []int{-68, 30, 0, 8}; !slices.Equal(d.rotations, want) {
+		t.Fatalf("rotations: got %v, want %v", d.rotations, want)
+	}
+	if err := d.Parse([]string{"L68", "X30"}); err == nil {
+		t.Fatal("Parse accepted a malformed rotation")
+	}
+}
@@ -1,19 +1,145 @@
 package days
 
-var registry = map[int]func() Solution{}
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
 
-// Register adds a day solver constructor to the package registry so main can
-// instantiate solvers by day number at runtime.
+// DefaultYear is the event the solvers in this package belong to. Register,
+// Get, GetSolver and Days all operate on it.
+const DefaultYear = 2025
+
+// Info describes a registered solver for listings such as "aoc2025 list".
+type Info struct {
+	Year   int
+	Day    int
+	Title  string
+	Tags   []string // e.g. "grid", "graph", "ILP"
+	Author string
+	// Part2Missing marks days whose SolvePart2 is only a placeholder, so
+	// callers can report it as unimplemented instead of printing its answer.
+	Part2Missing bool
+}
+
+// puzzle identifies one registered solver by event year and day.
+type puzzle struct {
+	year, day int
+}
+
+type registration struct {
+	info        Info
+	constructor func() Solution
+}
+
+var registry = map[puzzle]registration{}
+
+// Register adds a day solver constructor for DefaultYear to the package
+// registry so main can instantiate solvers by day number at runtime.
 func Register(day int, constructor func() Solution) {
-	registry[day] = constructor
+	RegisterInfo(Info{Day: day}, constructor)
+}
+
+// RegisterYear adds a solver constructor for day of year. Solutions for other
+// events live in their own packages and register themselves through it.
+func RegisterYear(year, day int, constructor func() Solution) {
+	RegisterInfo(Info{Year: year, Day: day}, constructor)
+}
+
+// RegisterInfo adds a solver constructor together with its metadata; a zero
+// info.Year means DefaultYear. Like Register and RegisterYear it panics if a
+// solver is already registered for the same year and day, since that is
+// always a copy-paste mistake in an init function.
+func RegisterInfo(info Info, constructor func() Solution) {
+	if info.Year == 0 {
+		info.Year = DefaultYear
+	}
+	if constructor == nil {
+		panic(fmt.Sprintf("days: nil constructor for %d day %d", info.Year, info.Day))
+	}
+
+	key := puzzle{info.Year, info.Day}
+	if _, dup := registry[key]; dup {
+		panic(fmt.Sprintf("days: duplicate registration for %d day %d", info.Year, info.Day))
+	}
+	registry[key] = registration{info: info, constructor: constructor}
 }
 
 // Get looks up day in the registry and returns a fresh solver plus true, or nil
 // and false when no solver has been registered for that day.
 func Get(day int) (Solution, bool) {
-	constructor, ok := registry[day]
+	return GetYear(DefaultYear, day)
+}
+
+// GetYear is like Get for the given event year.
+func GetYear(year, day int) (Solution, bool) {
+	reg, ok := registry[puzzle{year, day}]
+	if !ok {
+		return nil, false
+	}
+	return reg.constructor(), true
+}
+
+// GetSolver is like Get but returns the day as a Solver, adapting legacy
+// Solution implementations so callers get error and cancellation handling.
+func GetSolver(day int) (Solver, bool) {
+	return GetSolverYear(DefaultYear, day)
+}
+
+// GetSolverYear is like GetSolver for the given event year.
+func GetSolverYear(year, day int) (Solver, bool) {
+	s, ok := GetYear(year, day)
 	if !ok {
 		return nil, false
 	}
-	return constructor(), true
+	return AsSolver(s), true
+}
+
+// Lookup returns the metadata registered for day of year.
+func Lookup(year, day int) (Info, bool) {
+	reg, ok := registry[puzzle{year, day}]
+	return reg.info, ok
+}
+
+// List returns the metadata of every registered solver, ordered by year and
+// then day.
+func List() []Info {
+	infos := make([]Info, 0, len(registry))
+	for _, reg := range registry {
+		infos = append(infos, reg.info)
+	}
+	slices.SortFunc(infos, func(a, b Info) int {
+		return cmp.Or(cmp.Compare(a.Year, b.Year), cmp.Compare(a.Day, b.Day))
+	})
+	return infos
+}
+
+// Days returns the registered day numbers of DefaultYear in ascending order.
+func Days() []int {
+	return DaysOfYear(DefaultYear)
+}
+
+// DaysOfYear returns the registered day numbers of year in ascending order.
+func DaysOfYear(year int) []int {
+	var dayNumbers []int
+	for p := range registry {
+		if p.year == year {
+			dayNumbers = append(dayNumbers, p.day)
+		}
+	}
+	slices.Sort(dayNumbers)
+	return dayNumbers
+}
+
+// Years returns every event year with at least one registered solver, in
+// ascending order.
+func Years() []int {
+	var years []int
+	for p := range registry {
+		if !slices.Contains(years, p.year) {
+			years = append(years, p.year)
+		}
+	}
+	slices.Sort(years)
+	return years
 }
@@ -0,0 +1,43 @@
+package days
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRegisterPanicsOnDuplicate(t *testing.T) {
+	const year = 1
+	t.Cleanup(func() { delete(registry, puzzle{year, 1}) })
+
+	RegisterYear(year, 1, func() Solution { return &day01{} })
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("second registration for the same day did not panic")
+		}
+	}()
+	RegisterYear(year, 1, func() Solution { return &day01{} })
+}
+
+func TestListIsOrderedAndCarriesMetadata(t *testing.T) {
+	infos := List()
+	if len(infos) != len(registry) {
+		t.Fatalf("List: got %d entries, want %d", len(infos), len(registry))
+	}
+	if !slices.IsSortedFunc(infos, func(a, b Info) int {
+		if a.Year != b.Year {
+			return a.Year - b.Year
+		}
+		return a.Day - b.Day
+	}) {
+		t.Fatalf("List is not ordered by year and day")
+	}
+
+	info, ok := Lookup(DefaultYear, 12)
+	if !ok {
+		t.Fatalf("Lookup: day 12 not registered")
+	}
+	if info.Title != "Christmas Tree Farm" || !info.Part2Missing {
+		t.Fatalf("Lookup day 12: got %+v", info)
+	}
+}
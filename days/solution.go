@@ -1,7 +1,118 @@
 package days
 
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
 type Solution interface {
 	SetInput(lines []string)
 	SolvePart1() string
 	SolvePart2() string
 }
+
+// Solver is the error-returning, context-aware successor to Solution. Parse
+// reports malformed input instead of silently producing zeros, and each part
+// can fail or be abandoned when ctx is cancelled.
+type Solver interface {
+	Parse(lines []string) error
+	Part1(ctx context.Context) (string, error)
+	Part2(ctx context.Context) (string, error)
+}
+
+// AsSolver returns s as a Solver. Days that already implement Solver are
+// returned unchanged; older days are wrapped in an adapter that turns panics
+// into errors and stops waiting on a part once ctx is done.
+func AsSolver(s Solution) Solver {
+	if solver, ok := s.(Solver); ok {
+		return solver
+	}
+	return &solutionAdapter{solution: s}
+}
+
+// solutionAdapter lets a legacy Solution satisfy the Solver interface.
+// running counts the parts still computing, including abandoned ones.
+type solutionAdapter struct {
+	solution Solution
+	running  sync.WaitGroup
+}
+
+// strictParser is implemented by Solutions that can validate their input.
+// Parse reports the first malformed line as a *ParseError where SetInput
+// would skip it or quietly read it as zero. The two are separate code, so on
+// well-formed input Parse must leave the solution exactly as SetInput does;
+// TestParseMatchesSetInput checks this on every puzzle example.
+type strictParser interface {
+	Parse(lines []string) error
+}
+
+// Parse hands lines to the wrapped solution's strict Parse when it has one and
+// to SetInput otherwise, reporting any panic as an error.
+func (a *solutionAdapter) Parse(lines []string) (err error) {
+	defer recoverError(&err)
+	if p, ok := a.solution.(strictParser); ok {
+		return p.Parse(lines)
+	}
+	a.solution.SetInput(lines)
+	return nil
+}
+
+// Part1 runs the wrapped SolvePart1, returning early if ctx is cancelled.
+func (a *solutionAdapter) Part1(ctx context.Context) (string, error) {
+	return a.runPart(ctx, a.solution.SolvePart1)
+}
+
+// Part2 runs the wrapped SolvePart2, returning early if ctx is cancelled.
+func (a *solutionAdapter) Part2(ctx context.Context) (string, error) {
+	return a.runPart(ctx, a.solution.SolvePart2)
+}
+
+type partResult struct {
+	answer string
+	err    error
+}
+
+// runPart calls solve on its own goroutine so a cancelled ctx can be honoured
+// even though the legacy solver itself never checks for cancellation. Only
+// the wait is abandoned: solve keeps running until it returns, and its answer
+// is then discarded. Wait reports when it has.
+func (a *solutionAdapter) runPart(ctx context.Context, solve func() string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	done := make(chan partResult, 1)
+	a.running.Add(1)
+	go func() {
+		defer a.running.Done()
+		var res partResult
+		defer func() { done <- res }()
+		defer recoverError(&res.err)
+		res.answer = solve()
+	}()
+
+	select {
+	case res := <-done:
+		return res.answer, res.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// Wait blocks until no part of s is still computing. Solvers that honour ctx
+// are done once Part1 or Part2 returns, but a legacy Solution wrapped by
+// AsSolver keeps computing an abandoned part until it finishes on its own.
+func Wait(s Solver) {
+	if a, ok := s.(*solutionAdapter); ok {
+		a.running.Wait()
+	}
+}
+
+// recoverError converts a panic in the current goroutine into an error stored
+// in *err. It must be called directly by a deferred statement.
+func recoverError(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("panic: %v", r)
+	}
+}
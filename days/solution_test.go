@@ -0,0 +1,45 @@
+package days
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type panickySolution struct{}
+
+func (panickySolution) SetInput(lines []string) { panic("bad input") }
+func (panickySolution) SolvePart1() string      { panic("no part 1") }
+func (panickySolution) SolvePart2() string      { return "2" }
+
+func TestAsSolverRecoversPanics(t *testing.T) {
+	s := AsSolver(panickySolution{})
+
+	if err := s.Parse(nil); err == nil {
+		t.Fatalf("Parse: expected error from panicking SetInput")
+	}
+	if _, err := s.Part1(context.Background()); err == nil {
+		t.Fatalf("Part1: expected error from panicking SolvePart1")
+	}
+	got, err := s.Part2(context.Background())
+	if err != nil || got != "2" {
+		t.Fatalf("Part2: got %q, %v; want \"2\", nil", got, err)
+	}
+}
+
+func TestAsSolverHonoursCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s := AsSolver(panickySolution{})
+	if _, err := s.Part2(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Part2: got %v, want context.Canceled", err)
+	}
+}
+
+func TestAsSolverKeepsNativeSolver(t *testing.T) {
+	d := &day10{}
+	if s := AsSolver(d); s != Solver(d) {
+		t.Fatalf("AsSolver wrapped a type that already implements Solver")
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"aoc2025/aocnet"
+	"aoc2025/days"
+)
+
+// problemsFile holds the day titles and descriptions shown by --verbose.
+const problemsFile = "problems.yaml"
+
+// runDescribe implements "aoc2025 describe [--update] [<days>]": it fetches
+// the puzzle page of each selected day (every registered day of the year by
+// default) and prints the extracted title and description, or with
+// --update merges them into problems.yaml (problems-YYYY.yaml for other
+// years). Existing entries are never dropped, and hand-written descriptions
+// are kept in preference to the generated ones. It returns the process exit
+// code.
+func runDescribe(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	update := false
+	var dayArgs []string
+	for _, arg := range args {
+		if arg == "--update" {
+			update = true
+			continue
+		}
+		dayArgs = append(dayArgs, arg)
+	}
+	if len(dayArgs) == 0 {
+		dayArgs = []string{"all"}
+	}
+	dayNumbers, err := parseDaySelection(dayArgs, days.DaysOfYear(year))
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("Usage: ./aoc2025 describe [--year YYYY] [--update] [<days>]")
+		return 1
+	}
+
+	path := yearFile(problemsFile, year)
+	descriptions := map[int]problemDescription{}
+	if update {
+		loaded, err := LoadProblemDescriptions(path)
+		if err != nil && !os.IsNotExist(err) {
+			fmt.Printf("Error loading %s: %v\n", path, err)
+			return 1
+		}
+		if loaded != nil {
+			descriptions = loaded
+		}
+	}
+
+	session := sessionToken()
+	failed := false
+	for _, day := range dayNumbers {
+		puzzle, err := aocClient(year).FetchPuzzle(day, session)
+		if errors.Is(err, aocnet.ErrNotUnlocked) {
+			fmt.Printf("Day %d: not unlocked yet\n", day)
+			continue
+		}
+		if err != nil {
+			fmt.Printf("Day %d: %s\n", day, describeFetchError(err))
+			failed = true
+			continue
+		}
+
+		fmt.Printf("Day %d: %s\n", day, puzzle.Title)
+		if !update {
+			fmt.Printf("  %s\n", puzzle.Description)
+			continue
+		}
+
+		problem := descriptions[day]
+		problem.Title = puzzle.Title
+		if problem.Description == "" {
+			problem.Description = puzzle.Description
+		}
+		descriptions[day] = problem
+	}
+
+	if update {
+		if err := SaveProblemDescriptions(path, descriptions); err != nil {
+			fmt.Printf("Error writing %s: %v\n", path, err)
+			return 1
+		}
+		fmt.Printf("Updated %s\n", path)
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
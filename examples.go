@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"aoc2025/aocnet"
+	"aoc2025/days"
+)
+
+// examplesDir is where extracted examples are written; the generic example
+// test in package days discovers them there.
+const examplesDir = "days/testdata"
+
+// runExamples implements "aoc2025 examples [--year YYYY] <day> [<page.html>]":
+// it extracts the worked examples from a saved puzzle page, or from the live
+// page when no file is given, and writes them as
+// days/testdata/YYYY/dayNN/exampleK.in with the expected answers in the
+// matching .out file. It returns the process exit code.
+func runExamples(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+	if len(args) < 1 || len(args) > 2 {
+		fmt.Println("Usage: ./aoc2025 examples [--year YYYY] <day> [<page.html>]")
+		return 1
+	}
+
+	selected, err := parseDaySelection(args[:1], days.DaysOfYear(year))
+	if err != nil || len(selected) != 1 {
+		if err == nil {
+			err = fmt.Errorf("select a single day, not %s", args[0])
+		}
+		fmt.Println(err)
+		return 1
+	}
+	day := selected[0]
+
+	var page string
+	if len(args) == 2 {
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			fmt.Printf("Error reading %s: %v\n", args[1], err)
+			return 1
+		}
+		page = string(data)
+	} else {
+		puzzle, err := aocClient(year).FetchPuzzle(day, sessionToken())
+		if err != nil {
+			fmt.Printf("Error fetching puzzle for day %d: %s\n", day, describeFetchError(err))
+			return 1
+		}
+		page = puzzle.HTML
+	}
+
+	examples := aocnet.ExtractExamples(page)
+	if len(examples) == 0 {
+		fmt.Printf("No examples found for day %d\n", day)
+		return 1
+	}
+
+	dir := filepath.Join(examplesDir, strconv.Itoa(year), fmt.Sprintf("day%02d", day))
+	if err := ensureDir(dir); err != nil {
+		fmt.Printf("Error creating %s: %v\n", dir, err)
+		return 1
+	}
+
+	for i, ex := range examples {
+		base := filepath.Join(dir, fmt.Sprintf("example%d", i+1))
+		if err := writeExample(base, ex); err != nil {
+			fmt.Printf("Error writing %s: %v\n", base, err)
+			return 1
+		}
+		fmt.Printf("Wrote %s.in (part 1: %s, part 2: %s)\n", base, orDash(ex.Part1), orDash(ex.Part2))
+	}
+	return 0
+}
+
+// writeExample writes ex to base+".in" and its known answers to base+".out",
+// one "partN: answer" line per answer the puzzle page states.
+func writeExample(base string, ex aocnet.Example) error {
+	if err := os.WriteFile(base+".in", []byte(ex.Input), 0644); err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if ex.Part1 != "" {
+		fmt.Fprintf(&out, "part1: %s\n", ex.Part1)
+	}
+	if ex.Part2 != "" {
+		fmt.Fprintf(&out, "part2: %s\n", ex.Part2)
+	}
+	return os.WriteFile(base+".out", []byte(out.String()), 0644)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
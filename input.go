@@ -2,87 +2,145 @@ package main
 
 import (
 	"aoc2025/aocnet"
-	"bufio"
+	"aoc2025/days"
+	"aoc2025/puzzleinput"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 )
 
-// ReadLocalInput reads the file at path line by line and returns the input as
-// a slice of strings, or an error if the file cannot be opened or scanned.
+// inputLoader returns the puzzle input lines for day of year.
+// FetchOrReadInput is the default; --input substitutes a fixed file.
+type inputLoader func(year, day int) ([]string, error)
+
+// ReadLocalInput reads the file at path and returns its lines, normalized as
+// described in package puzzleinput, or an error if the file cannot be read.
 func ReadLocalInput(path string) ([]string, error) {
-	f, err := os.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer f.Close()
+	return puzzleinput.ReadFile(path)
+}
 
+// fixedInput returns an inputLoader that ignores the requested day and serves
+// the input named by --input: a file path, or "-" for standard input. The
+// input is read once, up front, so stdin can serve every caller.
+func fixedInput(path string) (inputLoader, error) {
 	var lines []string
-	sc := bufio.NewScanner(f)
-	for sc.Scan() {
-		lines = append(lines, sc.Text())
+	var err error
+	if path == "-" {
+		lines, err = puzzleinput.Read(os.Stdin)
+	} else {
+		lines, err = ReadLocalInput(path)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return lines, sc.Err()
+	return func(year, day int) ([]string, error) {
+		return lines, nil
+	}, nil
 }
 
-// FetchOrReadInput loads the puzzle input for day, preferring an online fetch
-// when AOC_ONLINE=1 and AOC_SESSION is set, then falling back to the local
-// input/dayXX.txt cache. It returns the input lines or the cache-read error.
-func FetchOrReadInput(day int) ([]string, error) {
-	session := os.Getenv("AOC_SESSION")
+// FetchOrReadInput loads the puzzle input for day of year, preferring an
+// online fetch when AOC_ONLINE=1 and a session is available (see
+// sessionToken), then falling back to the local input/YYYY/dayXX.txt cache
+// under inputDir. A cached input that fails its checksum is never refetched
+// behind the user's back, since the usual cause is a hand edit; the error
+// says how to keep the edit or fetch a fresh copy. It returns the input lines
+// or the cache-read error.
+func FetchOrReadInput(year, day int) ([]string, error) {
 	online := os.Getenv("AOC_ONLINE") == "1"
 
 	if online {
-		if session == "" {
-			fmt.Println("AOC_ONLINE=1 but AOC_SESSION is not set.")
-		} else {
-			lines, err := aocnet.FetchInput(day, session)
-			if err == nil {
-				if err := writeInputCache(day, lines); err != nil {
-					fmt.Printf("Input cache write failed: %v\n", err)
-				}
-				return lines, nil
-			}
-			fmt.Printf("Network fetch failed: %v\n", err)
+		if session := sessionToken(); session == "" {
+			fmt.Fprintln(os.Stderr, "AOC_ONLINE=1 but no session is available; set AOC_SESSION or run ./aoc2025 login.")
+		} else if lines, err := fetchAndCache(year, day, session); err == nil {
+			return lines, nil
 		}
 	}
 
 	// Fall back to cached file
-	inputFile := filepath.Join("input", fmt.Sprintf("day%02d.txt", day))
-	return ReadLocalInput(inputFile)
+	lines, err := readInputCache(year, day)
+	if errors.Is(err, errCacheCorrupt) && !online {
+		return nil, explainCorruptCache(err, year, day)
+	}
+	return lines, err
 }
 
-// ensureDir verifies that name exists as a directory, creating it when missing,
-// and returns any filesystem error encountered along the way.
-func ensureDir(name string) error {
-	if _, err := os.Stat(name); os.IsNotExist(err) {
-		return os.MkdirAll(name, 0755)
-	} else if err != nil {
-		return err
+// explainCorruptCache adds to a checksum mismatch for day of year the two
+// ways out: accepting the edited file or refetching the input.
+func explainCorruptCache(err error, year, day int) error {
+	yearFlag := ""
+	if year != days.DefaultYear {
+		yearFlag = fmt.Sprintf(" --year %d", year)
 	}
-	return nil
+	return fmt.Errorf("%w; run ./aoc2025 inputs%s accept %d to keep your edits, or set AOC_ONLINE=1 to fetch it again", err, yearFlag, day)
 }
 
-// writeInputCache writes lines to input/dayXX.txt for day, creating the input
-// directory if needed, and returns the first write or filesystem error.
-func writeInputCache(day int, lines []string) error {
-	if err := ensureDir("input"); err != nil {
-		return err
+// fetchAndCache downloads the input for day of year and refreshes the cache.
+// A failed fetch is reported on stderr as well as returned.
+func fetchAndCache(year, day int, session string) ([]string, error) {
+	lines, err := aocClient(year).FetchInput(day, session)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Network fetch failed: %s\n", describeFetchError(err))
+		return nil, err
+	}
+	if err := writeInputCache(year, day, lines, session); err != nil {
+		fmt.Fprintf(os.Stderr, "Input cache write failed: %v\n", err)
 	}
+	return lines, nil
+}
 
-	path := filepath.Join("input", fmt.Sprintf("day%02d.txt", day))
-	f, err := os.Create(path)
-	if err != nil {
-		return err
+// inputDir returns the root of the input cache: $AOC_INPUT_DIR when set, so
+// the binary can run from any directory, and "input" next to the working
+// directory otherwise.
+func inputDir() string {
+	if dir := os.Getenv("AOC_INPUT_DIR"); dir != "" {
+		return dir
 	}
-	defer f.Close()
+	return "input"
+}
 
-	for _, l := range lines {
-		if _, err := f.WriteString(l + "\n"); err != nil {
-			return err
-		}
+// inputPath returns the cache location input/YYYY/dayXX.txt for day of year.
+func inputPath(year, day int) string {
+	return filepath.Join(inputDir(), strconv.Itoa(year), fmt.Sprintf("day%02d.txt", day))
+}
+
+// legacyInputPath returns the pre-multi-year cache location input/dayXX.txt,
+// which only ever held inputs for days.DefaultYear.
+func legacyInputPath(day int) string {
+	return filepath.Join(inputDir(), fmt.Sprintf("day%02d.txt", day))
+}
+
+// aocClient returns an Advent of Code client for the given event year.
+func aocClient(year int) *aocnet.Client {
+	return &aocnet.Client{Year: year}
+}
+
+// describeFetchError turns an aocnet error into advice the user can act on,
+// falling back to the error text for causes it does not recognise.
+func describeFetchError(err error) string {
+	switch {
+	case errors.Is(err, aocnet.ErrSessionExpired):
+		return "your session token was rejected, most likely because it expired; log in to adventofcode.com again and run ./aoc2025 login (or refresh AOC_SESSION)"
+	case errors.Is(err, aocnet.ErrNotUnlocked):
+		return "this puzzle is not unlocked yet"
+	case errors.Is(err, aocnet.ErrRateLimited):
+		return "the server is rate limiting requests; try again later"
+	case errors.Is(err, aocnet.ErrTransient):
+		return fmt.Sprintf("the server could not be reached after retrying (%s)", redactSession(err.Error()))
+	default:
+		return redactSession(err.Error())
 	}
+}
 
+// ensureDir verifies that name exists as a directory, creating it when missing,
+// and returns any filesystem error encountered along the way.
+func ensureDir(name string) error {
+	if _, err := os.Stat(name); os.IsNotExist(err) {
+		return os.MkdirAll(name, 0755)
+	} else if err != nil {
+		return err
+	}
 	return nil
 }
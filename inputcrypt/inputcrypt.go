@@ -0,0 +1,113 @@
+// Package inputcrypt seals puzzle inputs with a passphrase so they can be
+// committed without publishing them.
+//
+// A sealed file is laid out as
+//
+//	magic "AOCENC1" | iterations (uint32, big endian) | salt (16) | nonce (12) | ciphertext
+//
+// The key is derived from the passphrase and salt with PBKDF2-HMAC-SHA256 and
+// the ciphertext is AES-256-GCM. Everything before the ciphertext is
+// authenticated along with a caller-supplied label, so a sealed input cannot
+// be swapped for another day's without Open noticing.
+package inputcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	magic     = "AOCENC1"
+	saltSize  = 16
+	nonceSize = 12
+	keySize   = 32
+
+	// Iterations is the PBKDF2 work factor used by Seal. It is stored in each
+	// file, so raising it later does not break existing files.
+	Iterations = 600_000
+
+	// maxIterations bounds the work factor Open accepts, so a damaged or
+	// hostile file cannot make it spin for hours.
+	maxIterations = 50_000_000
+)
+
+const headerSize = len(magic) + 4 + saltSize + nonceSize
+
+// ErrDecrypt is returned by Open when the passphrase is wrong, or the data or
+// its label were tampered with. GCM cannot tell these apart.
+var ErrDecrypt = errors.New("wrong passphrase or corrupted data")
+
+// Label is the label a puzzle input for day of year is sealed with, so a file
+// copied to another day's name fails to open.
+func Label(year, day int) string {
+	return fmt.Sprintf("%d/day%02d", year, day)
+}
+
+// Seal encrypts plaintext under passphrase, binding it to label.
+func Seal(passphrase string, plaintext []byte, label string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("empty passphrase")
+	}
+
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint32(header[len(magic):], Iterations)
+	salt := header[len(magic)+4 : len(magic)+4+saltSize]
+	nonce := header[len(magic)+4+saltSize:]
+	if _, err := rand.Read(header[len(magic)+4:]); err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(passphrase, salt, Iterations)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(header, nonce, plaintext, additionalData(header, label)), nil
+}
+
+// Open decrypts data produced by Seal with the same passphrase and label.
+func Open(passphrase string, data []byte, label string) ([]byte, error) {
+	if len(data) < headerSize || string(data[:len(magic)]) != magic {
+		return nil, errors.New("not an encrypted input")
+	}
+
+	header := data[:headerSize]
+	iterations := binary.BigEndian.Uint32(header[len(magic):])
+	if iterations == 0 || iterations > maxIterations {
+		return nil, fmt.Errorf("unsupported iteration count %d", iterations)
+	}
+	salt := header[len(magic)+4 : len(magic)+4+saltSize]
+	nonce := header[len(magic)+4+saltSize:]
+
+	aead, err := newAEAD(passphrase, salt, int(iterations))
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, nonce, data[headerSize:], additionalData(header, label))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+func newAEAD(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, keySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func additionalData(header []byte, label string) []byte {
+	return append(append([]byte(nil), header...), label...)
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"aoc2025/days"
+	"aoc2025/inputcrypt"
+	"aoc2025/puzzleinput"
+)
+
+const inputsUsage = "Usage: ./aoc2025 inputs [--year YYYY] encrypt|decrypt|accept [--force] [<days>]"
+
+// runInputs implements "aoc2025 inputs [--year YYYY] encrypt|decrypt|accept
+// [--force] [<days>]". encrypt writes input/YYYY/dayNN.txt.enc next to each
+// cached input, under the passphrase in AOC_INPUT_PASSPHRASE; decrypt restores
+// the plaintext cache from them; accept records the current checksum of a
+// hand-edited input in its metadata file. Only the selected days (all by
+// default) that have a file to convert are touched. Files whose content is
+// already up to date are left alone, so re-encrypting does not churn version
+// control, and decrypt will not replace a differing plaintext input without
+// --force. It returns the process exit code.
+func runInputs(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+	if len(args) == 0 || (args[0] != "encrypt" && args[0] != "decrypt" && args[0] != "accept") {
+		fmt.Println(inputsUsage)
+		return 1
+	}
+	action := args[0]
+
+	force := false
+	var dayArgs []string
+	for _, arg := range args[1:] {
+		if arg == "--force" {
+			force = true
+			continue
+		}
+		dayArgs = append(dayArgs, arg)
+	}
+	if len(dayArgs) == 0 {
+		dayArgs = []string{"all"}
+	}
+	dayNumbers, err := parseDaySelection(dayArgs, days.DaysOfYear(year))
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println(inputsUsage)
+		return 1
+	}
+
+	passphrase := os.Getenv(passphraseEnv)
+	if passphrase == "" && action != "accept" {
+		fmt.Printf("%s is not set\n", passphraseEnv)
+		return 1
+	}
+
+	failed := false
+	for _, day := range dayNumbers {
+		var msg string
+		var err error
+		switch action {
+		case "encrypt":
+			msg, err = encryptInput(year, day, passphrase)
+		case "decrypt":
+			msg, err = decryptInput(year, day, passphrase, force)
+		case "accept":
+			msg, err = acceptInput(year, day)
+		}
+		if err != nil {
+			fmt.Printf("Day %d: %v\n", day, err)
+			failed = true
+		} else if msg != "" {
+			fmt.Printf("Day %d: %s\n", day, msg)
+		}
+	}
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// encryptInput seals the cached plaintext input for day of year into
+// input/YYYY/dayNN.txt.enc. It reports what it did, or "" when there is no
+// plaintext input for the day.
+func encryptInput(year, day int, passphrase string) (string, error) {
+	path := inputPath(year, day)
+	plainPath := path
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) && year == days.DefaultYear {
+		plainPath = legacyInputPath(day)
+	}
+	if _, err := os.Stat(plainPath); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+
+	lines, err := readCacheFile(plainPath, year, day)
+	if err != nil {
+		return "", err
+	}
+	data := joinLines(lines)
+
+	encPath := encryptedPath(path)
+	if existing, err := readEncryptedInput(encPath, year, day, passphrase); err == nil && bytes.Equal(existing, data) {
+		return "unchanged", nil
+	}
+
+	sealed, err := inputcrypt.Seal(passphrase, data, inputcrypt.Label(year, day))
+	if err != nil {
+		return "", err
+	}
+	if err := ensureDir(filepath.Dir(encPath)); err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(encPath, sealed, 0644); err != nil {
+		return "", err
+	}
+	return "encrypted to " + encPath, nil
+}
+
+// decryptInput restores input/YYYY/dayNN.txt from its encrypted copy under
+// passphrase. It reports what it did, or "" when the day has no encrypted
+// input.
+func decryptInput(year, day int, passphrase string, force bool) (string, error) {
+	path := inputPath(year, day)
+	encPath := encryptedPath(path)
+	if _, err := os.Stat(encPath); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	plain, err := readEncryptedInput(encPath, year, day, passphrase)
+	if err != nil {
+		return "", err
+	}
+	lines := puzzleinput.Split(plain)
+
+	if _, err := os.Stat(path); err == nil {
+		current, err := readCacheFile(path, year, day)
+		if err == nil && bytes.Equal(joinLines(current), joinLines(lines)) {
+			return "unchanged", nil
+		}
+		if !force {
+			return "", fmt.Errorf("%s does not match %s; use --force to overwrite it", path, encPath)
+		}
+	}
+
+	// The encrypted copy holds only the input, so the restored sidecar leaves
+	// out the fetch time and account rather than inventing them.
+	if err := storeInput(year, day, joinLines(lines), cacheMeta{}); err != nil {
+		return "", err
+	}
+	return "decrypted to " + path, nil
+}
+
+// acceptInput updates the checksum in the metadata file of the cached input
+// for day of year to match the file as it is now, so a deliberate edit is no
+// longer reported as corruption. The fetch time and account are kept. It
+// reports what it did, or "" when the day has no cached input with metadata.
+func acceptInput(year, day int) (string, error) {
+	path := inputPath(year, day)
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) && year == days.DefaultYear {
+		path = legacyInputPath(day)
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	raw, err := os.ReadFile(metaPath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	var meta cacheMeta
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return "", fmt.Errorf("%s: %w", metaPath(path), err)
+	}
+	if checksum(data) == meta.SHA256 {
+		return "unchanged", nil
+	}
+	meta.SHA256 = checksum(data)
+	if err := writeCacheMeta(path, meta); err != nil {
+		return "", err
+	}
+	return "accepted the edited " + path, nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"aoc2025/days"
+)
+
+// runList implements "aoc2025 list [--year YYYY]": it prints every registered
+// day with its title, which parts are implemented, tags and author. Titles
+// missing from the registry fall back to problems.yaml. It returns the
+// process exit code.
+func runList(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil || len(args) > 0 {
+		fmt.Println("Usage: ./aoc2025 list [--year YYYY]")
+		return 1
+	}
+
+	descriptions, err := LoadProblemDescriptions(yearFile(problemsFile, year))
+	if err != nil {
+		descriptions = map[int]problemDescription{}
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Day\tTitle\tPart 1\tPart 2\tTags\tAuthor")
+
+	listed := 0
+	for _, info := range days.List() {
+		if info.Year != year {
+			continue
+		}
+		listed++
+
+		title := info.Title
+		if title == "" {
+			title = descriptions[info.Day].Title
+		}
+		part2 := "done"
+		if info.Part2Missing {
+			part2 = "missing"
+		}
+
+		fmt.Fprintf(tw, "%2d\t%s\t%s\t%s\t%s\t%s\n",
+			info.Day, orDash(title), "done", part2, orDash(strings.Join(info.Tags, ", ")), orDash(info.Author))
+	}
+	tw.Flush()
+
+	if listed == 0 {
+		fmt.Printf("No solvers registered for %d\n", year)
+	}
+	return 0
+}
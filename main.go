@@ -1,99 +1,328 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"runtime"
 	"strconv"
+	"strings"
 
 	"aoc2025/days"
 )
 
+type options struct {
+	verbose bool
+	timing  bool
+	check   bool
+	record  bool
+	wait    bool
+	jobs    int
+	part    int
+	year    int
+	format  string
+	input   string
+	days    []int
+}
+
 func main() {
 	if len(os.Args) < 2 {
 		printUsage()
 		os.Exit(1)
 	}
 
-	verbose, dayArgs := parseArgs(os.Args[1:])
-	if len(dayArgs) == 0 {
+	switch os.Args[1] {
+	case "submit":
+		os.Exit(runSubmit(os.Args[2:]))
+	case "describe":
+		os.Exit(runDescribe(os.Args[2:]))
+	case "examples":
+		os.Exit(runExamples(os.Args[2:]))
+	case "list":
+		os.Exit(runList(os.Args[2:]))
+	case "watch":
+		os.Exit(runWatch(os.Args[2:]))
+	case "serve":
+		os.Exit(runServe(os.Args[2:]))
+	case "bench":
+		os.Exit(runBench(os.Args[2:]))
+	case "new":
+		os.Exit(runNew(os.Args[2:]))
+	case "inputs":
+		os.Exit(runInputs(os.Args[2:]))
+	case "login":
+		os.Exit(runLogin(os.Args[2:]))
+	}
+
+	opts, err := parseArgs(os.Args[1:])
+	if err != nil {
+		fmt.Println(err)
+		printUsage()
+		os.Exit(1)
+	}
+	if len(opts.days) == 0 {
 		printUsage()
 		os.Exit(1)
 	}
 
 	descriptions := map[int]problemDescription{}
-	if verbose {
-		loaded, err := LoadProblemDescriptions("problems.yaml")
+	if opts.verbose || opts.format != "text" {
+		loaded, err := LoadProblemDescriptions(yearFile(problemsFile, opts.year))
 		if err != nil {
-			fmt.Printf("Warning: could not load problem descriptions: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: could not load problem descriptions: %v\n", err)
 		} else {
 			descriptions = loaded
 		}
 	}
 
-	for _, arg := range dayArgs {
-		day, err := strconv.Atoi(arg)
-		if err != nil || day < 1 || day > 12 {
-			fmt.Printf("Invalid day: %s\n", arg)
-			continue
-		}
+	rep, err := newReporter(opts.format, os.Stdout, opts, descriptions)
+	if err != nil {
+		fmt.Println(err)
+		printUsage()
+		os.Exit(1)
+	}
 
-		solver, ok := days.Get(day)
-		if !ok {
-			fmt.Printf("No solver for day %d\n", day)
-			continue
+	answersPath := yearFile(answersFile, opts.year)
+	var answers map[int]knownAnswers
+	if opts.check || opts.record {
+		answers, err = LoadAnswers(answersPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading %s: %v\n", answersPath, err)
+			os.Exit(1)
 		}
+	}
 
-		lines, err := FetchOrReadInput(day)
+	load := inputLoader(FetchOrReadInput)
+	if opts.input != "" {
+		load, err = fixedInput(opts.input)
 		if err != nil {
-			fmt.Printf("Error loading input for day %d: %v\n", day, err)
-			continue
+			fmt.Fprintf(os.Stderr, "Error reading --input: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if opts.wait {
+		if os.Getenv("AOC_ONLINE") != "1" || sessionToken() == "" {
+			fmt.Println("--wait needs AOC_ONLINE=1 and a session (AOC_SESSION or ./aoc2025 login) to fetch the input once it unlocks.")
+			os.Exit(1)
+		}
+		for _, day := range opts.days {
+			if err := waitForUnlock(ctx, opts.year, day); err != nil {
+				fmt.Fprintln(os.Stderr, "Interrupted.")
+				os.Exit(1)
+			}
+		}
+	}
+
+	mismatches := 0
+	runDays(ctx, opts.year, opts.days, opts.part, opts.jobs, load, func(r dayResult) {
+		if opts.check && r.Err != nil {
+			// A day that could not be solved cannot match its answers.
+			r.Check = checkFail
+			mismatches++
+		} else if opts.check {
+			expected := answers[r.Day]
+			r.Part1.Check, r.Part1.Expected = checkPart(r.Part1, expected.Part1), expected.Part1
+			r.Part2.Check, r.Part2.Expected = checkPart(r.Part2, expected.Part2), expected.Part2
+			if r.Part1.Check == checkFail || r.Part2.Check == checkFail {
+				mismatches++
+			}
+		}
+		if opts.record {
+			recordAnswers(answers, r)
 		}
+		rep.Report(r)
+	})
 
-		solver.SetInput(lines)
+	if err := rep.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
+		os.Exit(1)
+	}
+	if ctx.Err() != nil {
+		fmt.Fprintln(os.Stderr, "Interrupted.")
+		os.Exit(1)
+	}
 
-		fmt.Printf("🌟 Day %d 🌟\n", day)
-		if verbose {
-			printProblemDescription(day, descriptions)
+	if opts.record {
+		if err := SaveAnswers(answersPath, answers); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", answersPath, err)
+			os.Exit(1)
 		}
-		fmt.Printf("Part 1: %s\n", solver.SolvePart1())
-		fmt.Printf("Part 2: %s\n", solver.SolvePart2())
-		fmt.Println()
+	}
+	if mismatches > 0 {
+		fmt.Fprintf(os.Stderr, "%d day(s) did not match %s\n", mismatches, answersPath)
+		os.Exit(1)
 	}
 }
 
-func parseArgs(args []string) (bool, []string) {
-	verbose := false
+// parseArgs splits the command line into flags and a day selection. It
+// returns an error for a malformed flag value or an invalid day selection.
+func parseArgs(args []string) (options, error) {
+	opts := options{
+		jobs:   1,
+		format: "text",
+		year:   days.DefaultYear,
+	}
 	dayArgs := make([]string, 0, len(args))
 
-	for _, arg := range args {
-		switch arg {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(arg, "=")
+
+		// needValue takes the flag value from "--flag=value" or, failing
+		// that, from the next argument.
+		needValue := func() error {
+			if hasValue {
+				return nil
+			}
+			if i+1 >= len(args) {
+				return fmt.Errorf("%s requires a value", name)
+			}
+			i++
+			value = args[i]
+			return nil
+		}
+
+		switch name {
 		case "-v", "--verbose":
-			verbose = true
+			opts.verbose = true
+		case "-t", "--time":
+			opts.timing = true
+		case "--check":
+			opts.check = true
+		case "--record":
+			opts.record = true
+		case "-w", "--wait":
+			opts.wait = true
 		case "-h", "--help":
 			printUsage()
 			os.Exit(0)
+		case "-j", "--jobs":
+			if err := needValue(); err != nil {
+				return opts, err
+			}
+			jobs, err := strconv.Atoi(value)
+			if err != nil || jobs < 0 {
+				return opts, fmt.Errorf("invalid %s value: %s", name, value)
+			}
+			if jobs == 0 {
+				jobs = runtime.NumCPU()
+			}
+			opts.jobs = jobs
+		case "-f", "--format":
+			if err := needValue(); err != nil {
+				return opts, err
+			}
+			opts.format = value
+		case "-i", "--input":
+			if err := needValue(); err != nil {
+				return opts, err
+			}
+			opts.input = value
+		case "-y", "--year":
+			if err := needValue(); err != nil {
+				return opts, err
+			}
+			year, err := strconv.Atoi(value)
+			if err != nil {
+				return opts, fmt.Errorf("invalid %s value: %s", name, value)
+			}
+			opts.year = year
+		case "-p", "--part":
+			if err := needValue(); err != nil {
+				return opts, err
+			}
+			part, err := strconv.Atoi(value)
+			if err != nil || (part != 1 && part != 2) {
+				return opts, fmt.Errorf("invalid %s value: %s (want 1 or 2)", name, value)
+			}
+			opts.part = part
 		default:
 			dayArgs = append(dayArgs, arg)
 		}
 	}
 
-	return verbose, dayArgs
+	selected, err := parseDaySelection(dayArgs, days.DaysOfYear(opts.year))
+	if err != nil {
+		return opts, err
+	}
+	opts.days = selected
+
+	if opts.input != "" {
+		// A custom input belongs to one puzzle and has no recorded answers.
+		switch {
+		case len(opts.days) > 1:
+			return opts, fmt.Errorf("--input needs exactly one day, got %d", len(opts.days))
+		case opts.check || opts.record:
+			return opts, fmt.Errorf("--input cannot be combined with --check or --record")
+		case opts.wait:
+			return opts, fmt.Errorf("--input cannot be combined with --wait")
+		}
+	}
+
+	return opts, nil
+}
+
+// splitYearFlag removes a "--year YYYY" (or "-y", "--year=YYYY") flag from a
+// subcommand's arguments and returns the year, days.DefaultYear when absent,
+// together with the remaining arguments.
+func splitYearFlag(args []string) (int, []string, error) {
+	year := days.DefaultYear
+	rest := make([]string, 0, len(args))
+
+	for i := 0; i < len(args); i++ {
+		name, value, hasValue := strings.Cut(args[i], "=")
+		if name != "-y" && name != "--year" {
+			rest = append(rest, args[i])
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return 0, nil, fmt.Errorf("%s requires a value", name)
+			}
+			i++
+			value = args[i]
+		}
+		y, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid %s value: %s", name, value)
+		}
+		year = y
+	}
+
+	return year, rest, nil
 }
 
-func printProblemDescription(day int, descriptions map[int]problemDescription) {
+func printProblemDescription(w io.Writer, day int, descriptions map[int]problemDescription) {
 	problem, ok := descriptions[day]
 	if !ok {
 		return
 	}
 
 	if problem.Title != "" {
-		fmt.Printf("%s\n", problem.Title)
+		fmt.Fprintf(w, "%s\n", problem.Title)
 	}
 	if problem.Description != "" {
-		fmt.Printf("%s\n", problem.Description)
+		fmt.Fprintf(w, "%s\n", problem.Description)
 	}
 }
 
 func printUsage() {
-	fmt.Println("Usage: ./aoc2025 [-v|--verbose] <day> [<day> ...]")
+	fmt.Println("Usage: ./aoc2025 [-v|--verbose] [-t|--time] [--check] [--record] [-w|--wait] [-j|--jobs N] [-p|--part 1|2] [-y|--year YYYY] [-f|--format text|json|ndjson] [-i|--input FILE|-] <days> ...")
+	fmt.Println("       <days> is a day (5), a range (1-5), a list (1,3,5) or all")
+	fmt.Println("       ./aoc2025 list [--year YYYY]")
+	fmt.Println("       ./aoc2025 submit [--year YYYY] <day> <part>")
+	fmt.Println("       ./aoc2025 describe [--year YYYY] [--update] [<days>]")
+	fmt.Println("       ./aoc2025 examples [--year YYYY] <day> [<page.html>]")
+	fmt.Println("       ./aoc2025 watch [--year YYYY] [--input FILE] [--source] [--interval DURATION] <day>")
+	fmt.Println("       ./aoc2025 serve [--year YYYY] [--addr HOST:PORT] [--timeout DURATION] [--max-solves N]")
+	fmt.Println("       ./aoc2025 bench [--year YYYY] [--warmup N] [--count N] [--json FILE] [<days>]")
+	fmt.Println("       ./aoc2025 new [--year YYYY] [--fetch] [--author NAME] <day>")
+	fmt.Println("       ./aoc2025 inputs [--year YYYY] encrypt|decrypt|accept [--force] [<days>]")
+	fmt.Println("       ./aoc2025 login [--check]")
 }
@@ -0,0 +1,634 @@
+// Package miniyaml parses the small subset of YAML used by this repository's
+// data files (problems.yaml, answers.yaml): block mappings and sequences,
+// flow sequences of scalars, plain, single- and double-quoted scalars, literal
+// (|) and folded (>) block scalars with chomping indicators, and comments.
+// Anchors, tags, flow mappings and multi-document streams are not supported
+// and are reported as errors rather than silently misread.
+package miniyaml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Kind is the type of a Node.
+type Kind int
+
+const (
+	ScalarNode Kind = iota
+	MappingNode
+	SequenceNode
+)
+
+func (k Kind) String() string {
+	switch k {
+	case MappingNode:
+		return "mapping"
+	case SequenceNode:
+		return "sequence"
+	default:
+		return "scalar"
+	}
+}
+
+// Node is one parsed value. Line is the 1-based source line it starts on, so
+// callers can report schema errors at the right place.
+type Node struct {
+	Kind  Kind
+	Line  int
+	Value string  // scalar text, after unquoting and block folding
+	Items []*Node // sequence items
+	Pairs []Pair  // mapping entries in source order
+}
+
+// Pair is one key/value entry of a mapping.
+type Pair struct {
+	Key     string
+	KeyLine int
+	Value   *Node
+}
+
+// Get returns the value stored under key in a mapping node, or nil.
+func (n *Node) Get(key string) *Node {
+	for _, p := range n.Pairs {
+		if p.Key == key {
+			return p.Value
+		}
+	}
+	return nil
+}
+
+// Error is a parse error tied to a source line.
+type Error struct {
+	Line int
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// Errorf returns an *Error for line, for use by callers validating a schema.
+func Errorf(line int, format string, args ...any) error {
+	return &Error{Line: line, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Parse parses a single YAML document. An empty document yields an empty
+// mapping.
+func Parse(data []byte) (*Node, error) {
+	if !utf8.Valid(data) {
+		return nil, &Error{Line: 1, Msg: "document is not valid UTF-8"}
+	}
+
+	text := strings.TrimPrefix(string(data), "\uFEFF")
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	p := &parser{lines: strings.Split(text, "\n")}
+
+	p.skipInsignificant()
+	if p.pos < len(p.lines) && strings.TrimRight(p.lines[p.pos], " ") == "---" {
+		p.pos++
+	}
+
+	p.skipInsignificant()
+	if p.pos >= len(p.lines) {
+		return &Node{Kind: MappingNode, Line: 1}, nil
+	}
+
+	node, err := p.parseBlock(0)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipInsignificant()
+	if p.pos < len(p.lines) {
+		return nil, p.errorf("unexpected content after document")
+	}
+	return node, nil
+}
+
+type parser struct {
+	lines []string
+	pos   int
+}
+
+func (p *parser) line() int { return p.pos + 1 }
+
+func (p *parser) errorf(format string, args ...any) error {
+	return &Error{Line: p.line(), Msg: fmt.Sprintf(format, args...)}
+}
+
+// skipInsignificant advances past blank and comment-only lines.
+func (p *parser) skipInsignificant() {
+	for p.pos < len(p.lines) {
+		content := strings.TrimLeft(p.lines[p.pos], " ")
+		if content != "" && !strings.HasPrefix(content, "#") {
+			return
+		}
+		p.pos++
+	}
+}
+
+// current returns the indentation and content of the current line, or an
+// error if it is indented with tabs.
+func (p *parser) current() (int, string, error) {
+	raw := p.lines[p.pos]
+	content := strings.TrimLeft(raw, " ")
+	if strings.HasPrefix(content, "\t") {
+		return 0, "", p.errorf("tabs are not allowed for indentation")
+	}
+	return len(raw) - len(content), strings.TrimRight(content, " \t"), nil
+}
+
+// parseBlock parses the node starting on the current line, which must be
+// indented by at least minIndent.
+func (p *parser) parseBlock(minIndent int) (*Node, error) {
+	p.skipInsignificant()
+	if p.pos >= len(p.lines) {
+		return &Node{Kind: ScalarNode, Line: p.line()}, nil
+	}
+
+	indent, content, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	if indent < minIndent {
+		return &Node{Kind: ScalarNode, Line: p.line()}, nil
+	}
+
+	switch {
+	case content == "-" || strings.HasPrefix(content, "- "):
+		return p.parseSequence(indent)
+	case isKeyLine(content):
+		return p.parseMapping(indent)
+	default:
+		line := p.line()
+		node, err := p.parseValue(indent-1, content)
+		if err != nil {
+			return nil, err
+		}
+		node.Line = line
+		return node, nil
+	}
+}
+
+// parseMapping parses consecutive "key: value" lines at exactly indent.
+func (p *parser) parseMapping(indent int) (*Node, error) {
+	node := &Node{Kind: MappingNode, Line: p.line()}
+	seen := map[string]bool{}
+
+	for {
+		p.skipInsignificant()
+		if p.pos >= len(p.lines) {
+			return node, nil
+		}
+		lineIndent, content, err := p.current()
+		if err != nil {
+			return nil, err
+		}
+		if lineIndent < indent {
+			return node, nil
+		}
+		if lineIndent > indent {
+			return nil, p.errorf("unexpected indentation")
+		}
+		if !isKeyLine(content) {
+			return nil, p.errorf("expected \"key: value\", got %q", content)
+		}
+
+		keyLine := p.line()
+		key, rest, err := splitKey(content)
+		if err != nil {
+			return nil, &Error{Line: keyLine, Msg: err.Error()}
+		}
+		if seen[key] {
+			return nil, &Error{Line: keyLine, Msg: fmt.Sprintf("duplicate key %q", key)}
+		}
+		seen[key] = true
+
+		value, err := p.parseMappingValue(indent, rest)
+		if err != nil {
+			return nil, err
+		}
+		node.Pairs = append(node.Pairs, Pair{Key: key, KeyLine: keyLine, Value: value})
+	}
+}
+
+// parseMappingValue parses what follows "key:", which is either on the same
+// line or a nested block on the following lines.
+func (p *parser) parseMappingValue(indent int, rest string) (*Node, error) {
+	if stripComment(rest) != "" {
+		return p.parseValue(indent, rest)
+	}
+
+	keyLine := p.line()
+	p.pos++
+	p.skipInsignificant()
+	if p.pos >= len(p.lines) {
+		return &Node{Kind: ScalarNode, Line: keyLine}, nil
+	}
+
+	nextIndent, content, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case nextIndent > indent:
+		return p.parseBlock(indent + 1)
+	case nextIndent == indent && (content == "-" || strings.HasPrefix(content, "- ")):
+		// YAML allows a sequence value at the same indentation as its key.
+		return p.parseSequence(indent)
+	default:
+		return &Node{Kind: ScalarNode, Line: keyLine}, nil
+	}
+}
+
+// parseSequence parses consecutive "- item" lines at exactly indent.
+func (p *parser) parseSequence(indent int) (*Node, error) {
+	node := &Node{Kind: SequenceNode, Line: p.line()}
+
+	for {
+		p.skipInsignificant()
+		if p.pos >= len(p.lines) {
+			return node, nil
+		}
+		lineIndent, content, err := p.current()
+		if err != nil {
+			return nil, err
+		}
+		if lineIndent < indent {
+			return node, nil
+		}
+		if lineIndent > indent {
+			return nil, p.errorf("unexpected indentation")
+		}
+		if content != "-" && !strings.HasPrefix(content, "- ") {
+			return node, nil
+		}
+
+		if content == "-" {
+			itemLine := p.line()
+			p.pos++
+			p.skipInsignificant()
+			item := &Node{Kind: ScalarNode, Line: itemLine}
+			if p.pos < len(p.lines) {
+				if next, _, err := p.current(); err == nil && next > indent {
+					if item, err = p.parseBlock(indent + 1); err != nil {
+						return nil, err
+					}
+				}
+			}
+			node.Items = append(node.Items, item)
+			continue
+		}
+
+		// Blank out the dash so the item's content can be parsed as a block
+		// indented to its own column, which handles "- key: value" items.
+		raw := p.lines[p.pos]
+		p.lines[p.pos] = raw[:lineIndent] + " " + raw[lineIndent+1:]
+		item, err := p.parseBlock(indent + 1)
+		if err != nil {
+			return nil, err
+		}
+		node.Items = append(node.Items, item)
+	}
+}
+
+// parseValue parses an inline value that starts on the current line. indent
+// is the indentation of the owning key or item; block scalars must be
+// indented further than it. The parser advances past everything consumed.
+func (p *parser) parseValue(indent int, text string) (*Node, error) {
+	line := p.line()
+	text = strings.TrimLeft(text, " ")
+
+	switch {
+	case strings.HasPrefix(text, "|") || strings.HasPrefix(text, ">"):
+		return p.parseBlockScalar(indent, text)
+	case strings.HasPrefix(text, "["):
+		node, err := parseFlowSequence(text)
+		if err != nil {
+			return nil, &Error{Line: line, Msg: err.Error()}
+		}
+		node.Line = line
+		p.pos++
+		return node, nil
+	case strings.HasPrefix(text, "{"):
+		return nil, p.errorf("flow mappings are not supported")
+	case strings.HasPrefix(text, "&") || strings.HasPrefix(text, "*") || strings.HasPrefix(text, "!"):
+		return nil, p.errorf("anchors, aliases and tags are not supported")
+	}
+
+	value, rest, err := parseScalar(text)
+	if err != nil {
+		return nil, &Error{Line: line, Msg: err.Error()}
+	}
+	if stripComment(rest) != "" {
+		return nil, &Error{Line: line, Msg: fmt.Sprintf("unexpected text after value: %q", rest)}
+	}
+	p.pos++
+
+	// Multi-line plain or quoted scalars are outside the subset; catch
+	// them here instead of misreading the continuation as a new key.
+	p.skipInsignificant()
+	if p.pos < len(p.lines) {
+		if next, content, err := p.current(); err == nil && next > indent && !isKeyLine(content) &&
+			!(content == "-" || strings.HasPrefix(content, "- ")) {
+			return nil, p.errorf("multi-line scalars must use | or >")
+		}
+	}
+
+	return &Node{Kind: ScalarNode, Line: line, Value: value}, nil
+}
+
+// parseBlockScalar parses a literal (|) or folded (>) block scalar whose
+// header is on the current line and whose content lines follow, indented
+// beyond indent.
+func (p *parser) parseBlockScalar(indent int, header string) (*Node, error) {
+	line := p.line()
+	style := header[0]
+	chomp := byte(0)
+
+	indicator := stripComment(header[1:])
+	for _, c := range indicator {
+		switch {
+		case (c == '-' || c == '+') && chomp == 0:
+			chomp = byte(c)
+		default:
+			return nil, p.errorf("unsupported block scalar header %q", header)
+		}
+	}
+	p.pos++
+
+	// Collect the content lines: blank lines, and lines indented deeper than
+	// the owner. The first non-blank line fixes the content indentation.
+	contentIndent := -1
+	var raw []string
+	for p.pos < len(p.lines) {
+		l := strings.TrimRight(p.lines[p.pos], " \t")
+		if l == "" {
+			raw = append(raw, "")
+			p.pos++
+			continue
+		}
+		lineIndent := len(l) - len(strings.TrimLeft(l, " "))
+		if lineIndent <= indent {
+			break
+		}
+		if contentIndent < 0 {
+			contentIndent = lineIndent
+		}
+		if lineIndent < contentIndent {
+			return nil, p.errorf("block scalar line is indented less than the first line")
+		}
+		raw = append(raw, p.lines[p.pos][contentIndent:])
+		p.pos++
+	}
+
+	// Trailing blank lines belong to the chomping decision, not the content.
+	trailing := 0
+	for len(raw) > 0 && raw[len(raw)-1] == "" {
+		raw = raw[:len(raw)-1]
+		trailing++
+	}
+	// Give the blank lines back to the document so the caller sees them.
+	p.pos -= trailing
+
+	var value string
+	if style == '|' {
+		value = strings.Join(raw, "\n")
+	} else {
+		value = fold(raw)
+	}
+
+	if len(raw) > 0 {
+		switch chomp {
+		case '-':
+		case '+':
+			value += strings.Repeat("\n", trailing+1)
+		default:
+			value += "\n"
+		}
+	}
+
+	return &Node{Kind: ScalarNode, Line: line, Value: value}, nil
+}
+
+// fold joins the lines of a folded block scalar: single line breaks become
+// spaces, blank lines become line breaks, and more-indented lines keep their
+// breaks.
+func fold(lines []string) string {
+	var b strings.Builder
+	for i, l := range lines {
+		if i > 0 {
+			prev := lines[i-1]
+			switch {
+			case l == "":
+				b.WriteByte('\n')
+			case prev == "":
+				// The blank line before already produced the break.
+			case strings.HasPrefix(l, " ") || strings.HasPrefix(prev, " "):
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(l)
+	}
+	return b.String()
+}
+
+// parseFlowSequence parses a single-line "[a, 'b', "c"]" sequence of scalars.
+func parseFlowSequence(text string) (*Node, error) {
+	node := &Node{Kind: SequenceNode}
+	rest := strings.TrimLeft(text[1:], " ")
+
+	if strings.HasPrefix(rest, "]") {
+		rest = rest[1:]
+	} else {
+		for {
+			var value string
+			var err error
+			if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+				value, rest, err = parseScalar(rest)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				end := strings.IndexAny(rest, ",]")
+				if end < 0 {
+					return nil, fmt.Errorf("unterminated flow sequence")
+				}
+				value, rest = strings.TrimSpace(rest[:end]), rest[end:]
+				if strings.ContainsAny(value, "[{") {
+					return nil, fmt.Errorf("nested flow collections are not supported")
+				}
+			}
+			node.Items = append(node.Items, &Node{Kind: ScalarNode, Value: value})
+
+			rest = strings.TrimLeft(rest, " ")
+			if strings.HasPrefix(rest, ",") {
+				rest = strings.TrimLeft(rest[1:], " ")
+				continue
+			}
+			if strings.HasPrefix(rest, "]") {
+				rest = rest[1:]
+				break
+			}
+			return nil, fmt.Errorf("expected , or ] in flow sequence")
+		}
+	}
+
+	if stripComment(rest) != "" {
+		return nil, fmt.Errorf("unexpected text after flow sequence: %q", rest)
+	}
+	return node, nil
+}
+
+// parseScalar parses one scalar at the start of text and returns its value
+// and the unconsumed remainder.
+func parseScalar(text string) (string, string, error) {
+	switch {
+	case strings.HasPrefix(text, `"`):
+		return parseDoubleQuoted(text)
+	case strings.HasPrefix(text, `'`):
+		return parseSingleQuoted(text)
+	default:
+		value := stripComment(text)
+		return value, text[len(value):], nil
+	}
+}
+
+// parseDoubleQuoted decodes a double-quoted scalar, which uses backslash
+// escapes much like Go string literals.
+func parseDoubleQuoted(text string) (string, string, error) {
+	var b strings.Builder
+	for i := 1; i < len(text); i++ {
+		c := text[i]
+		switch c {
+		case '"':
+			return b.String(), text[i+1:], nil
+		case '\\':
+			if i+1 >= len(text) {
+				return "", "", fmt.Errorf("unterminated escape in double-quoted string")
+			}
+			i++
+			switch e := text[i]; e {
+			case '"', '\\', '/':
+				b.WriteByte(e)
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case '0':
+				b.WriteByte(0)
+			case 'a':
+				b.WriteByte('\a')
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'v':
+				b.WriteByte('\v')
+			case 'e':
+				b.WriteByte(0x1b)
+			case ' ':
+				b.WriteByte(' ')
+			case 'x', 'u', 'U':
+				width := map[byte]int{'x': 2, 'u': 4, 'U': 8}[e]
+				if i+width >= len(text) {
+					return "", "", fmt.Errorf("short \\%c escape in double-quoted string", e)
+				}
+				r, err := strconv.ParseUint(text[i+1:i+1+width], 16, 32)
+				if err != nil {
+					return "", "", fmt.Errorf("invalid \\%c escape in double-quoted string", e)
+				}
+				b.WriteRune(rune(r))
+				i += width
+			default:
+				return "", "", fmt.Errorf("unknown escape \\%c in double-quoted string", e)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated double-quoted string")
+}
+
+// parseSingleQuoted decodes a single-quoted scalar, where a doubled quote
+// stands for a single one.
+func parseSingleQuoted(text string) (string, string, error) {
+	var b strings.Builder
+	for i := 1; i < len(text); i++ {
+		if text[i] != '\'' {
+			b.WriteByte(text[i])
+			continue
+		}
+		if i+1 < len(text) && text[i+1] == '\'' {
+			b.WriteByte('\'')
+			i++
+			continue
+		}
+		return b.String(), text[i+1:], nil
+	}
+	return "", "", fmt.Errorf("unterminated single-quoted string")
+}
+
+// stripComment removes a trailing " # comment" from an unquoted value and
+// trims surrounding spaces.
+func stripComment(text string) string {
+	if strings.HasPrefix(strings.TrimLeft(text, " "), "#") {
+		return ""
+	}
+	if i := strings.Index(text, " #"); i >= 0 {
+		text = text[:i]
+	}
+	return strings.TrimSpace(text)
+}
+
+// isKeyLine reports whether content starts with a mapping key, i.e. a plain
+// or quoted key followed by ": " or a line-ending ":".
+func isKeyLine(content string) bool {
+	_, _, err := splitKey(content)
+	return err == nil
+}
+
+// splitKey splits "key: rest" into the decoded key and the text after the
+// colon.
+func splitKey(content string) (string, string, error) {
+	if content == "" {
+		return "", "", fmt.Errorf("missing key")
+	}
+
+	if content[0] == '"' || content[0] == '\'' {
+		key, rest, err := parseScalar(content)
+		if err != nil {
+			return "", "", err
+		}
+		rest = strings.TrimLeft(rest, " ")
+		if !strings.HasPrefix(rest, ":") || (len(rest) > 1 && rest[1] != ' ') {
+			return "", "", fmt.Errorf("expected : after quoted key")
+		}
+		return key, rest[1:], nil
+	}
+
+	if strings.HasPrefix(content, "#") || strings.HasPrefix(content, "[") || strings.HasPrefix(content, "{") {
+		return "", "", fmt.Errorf("not a key")
+	}
+
+	for i := 0; i < len(content); i++ {
+		if content[i] != ':' {
+			continue
+		}
+		if i+1 == len(content) || content[i+1] == ' ' {
+			key := strings.TrimSpace(content[:i])
+			if key == "" || strings.Contains(key, " #") {
+				return "", "", fmt.Errorf("missing key")
+			}
+			return key, content[i+1:], nil
+		}
+	}
+	return "", "", fmt.Errorf("not a key")
+}
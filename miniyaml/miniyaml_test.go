@@ -0,0 +1,180 @@
+package miniyaml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, doc string) *Node {
+	t.Helper()
+
+	node, err := Parse([]byte(doc))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	return node
+}
+
+func TestParseNestedMapping(t *testing.T) {
+	root := mustParse(t, `
+# leading comment
+1:
+  title: "Secret Entrance"   # trailing comment
+  description: Follow the dial.
+2:
+  title: 'Gift ''Shop'''
+`)
+
+	if root.Kind != MappingNode || len(root.Pairs) != 2 {
+		t.Fatalf("root: got %v with %d pairs", root.Kind, len(root.Pairs))
+	}
+	day1 := root.Get("1")
+	if got := day1.Get("title").Value; got != "Secret Entrance" {
+		t.Errorf("title: got %q", got)
+	}
+	if got := day1.Get("description").Value; got != "Follow the dial." {
+		t.Errorf("description: got %q", got)
+	}
+	if got := root.Get("2").Get("title").Value; got != "Gift 'Shop'" {
+		t.Errorf("single-quoted title: got %q", got)
+	}
+	if got := root.Pairs[1].KeyLine; got != 6 {
+		t.Errorf("key line: got %d, want 6", got)
+	}
+}
+
+func TestParseDoubleQuotedEscapes(t *testing.T) {
+	root := mustParse(t, `s: "say \"hi\"\tthen\\leave\n \u00e9 # not a comment"`)
+	want := "say \"hi\"\tthen\\leave\n é # not a comment"
+	if got := root.Get("s").Value; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestParseSequences(t *testing.T) {
+	root := mustParse(t, `
+flow: [grid, "graph, weighted", 'ILP']
+empty: []
+block:
+  - one
+  - two # comment
+same-indent:
+- a
+- b
+records:
+  - input: x
+    part1: "3"
+  - input: y
+`)
+
+	values := func(n *Node) []string {
+		var out []string
+		for _, item := range n.Items {
+			out = append(out, item.Value)
+		}
+		return out
+	}
+
+	if got := strings.Join(values(root.Get("flow")), "|"); got != "grid|graph, weighted|ILP" {
+		t.Errorf("flow: got %q", got)
+	}
+	if got := root.Get("empty"); got.Kind != SequenceNode || len(got.Items) != 0 {
+		t.Errorf("empty: got %+v", got)
+	}
+	if got := strings.Join(values(root.Get("block")), "|"); got != "one|two" {
+		t.Errorf("block: got %q", got)
+	}
+	if got := strings.Join(values(root.Get("same-indent")), "|"); got != "a|b" {
+		t.Errorf("same-indent: got %q", got)
+	}
+
+	records := root.Get("records")
+	if len(records.Items) != 2 {
+		t.Fatalf("records: got %d items", len(records.Items))
+	}
+	if got := records.Items[0].Get("part1").Value; got != "3" {
+		t.Errorf("records[0].part1: got %q", got)
+	}
+	if got := records.Items[1].Get("input").Value; got != "y" {
+		t.Errorf("records[1].input: got %q", got)
+	}
+}
+
+func TestParseBlockScalars(t *testing.T) {
+	root := mustParse(t, `
+literal: |
+  L68
+    indented
+
+  L30
+strip: |-
+  a
+  b
+
+keep: |+
+  a
+
+folded: >
+  one
+  two
+
+  three
+folded-strip: >-
+  x
+  y
+after: done
+`)
+
+	tests := map[string]string{
+		"literal":      "L68\n  indented\n\nL30\n",
+		"strip":        "a\nb",
+		"keep":         "a\n\n",
+		"folded":       "one two\nthree\n",
+		"folded-strip": "x y",
+		"after":        "done",
+	}
+	for key, want := range tests {
+		if got := root.Get(key).Value; got != want {
+			t.Errorf("%s: got %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestParseEmptyDocument(t *testing.T) {
+	root := mustParse(t, "# only a comment\n---\n")
+	if root.Kind != MappingNode || len(root.Pairs) != 0 {
+		t.Fatalf("got %+v, want empty mapping", root)
+	}
+}
+
+func TestParseErrorsHaveLineNumbers(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		line int
+	}{
+		{"unterminated quote", "a: ok\nb: \"oops\n", 2},
+		{"duplicate key", "a: 1\nb: 2\na: 3\n", 3},
+		{"bad indentation", "a:\n  b: 1\n    c: 2\n", 3},
+		{"tab indentation", "a:\n\tb: 1\n", 2},
+		{"multi-line plain scalar", "a: one\n  two\n", 2},
+		{"flow mapping", "a: {b: 1}\n", 1},
+		{"unknown escape", "a: \"\\q\"\n", 1},
+		{"text after quote", "a: \"x\" y\n", 1},
+		{"not a key", "a: 1\njust text\n", 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.doc))
+			var yerr *Error
+			if !errors.As(err, &yerr) {
+				t.Fatalf("got %v, want *Error", err)
+			}
+			if yerr.Line != tc.line {
+				t.Fatalf("line: got %d, want %d (%v)", yerr.Line, tc.line, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/template"
+
+	"aoc2025/days"
+)
+
+// daysDir is where "aoc2025 new" writes the solver and its benchmark.
+const daysDir = "days"
+
+// scaffold holds what the day templates are rendered with.
+type scaffold struct {
+	Day    int
+	Title  string
+	Author string
+}
+
+var dayTemplate = template.Must(template.New("day").Parse(`package days
+
+type day{{printf "%02d" .Day}} struct {
+	lines []string
+}
+
+func init() {
+	RegisterInfo(Info{
+		Day: {{.Day}},
+		Title: {{printf "%q" .Title}},
+		Author: {{printf "%q" .Author}},
+		// Part 2 unlocks after part 1 is solved; drop this once it is.
+		Part2Missing: true,
+	}, func() Solution { return &day{{printf "%02d" .Day}}{} })
+}
+
+// SetInput stores the non-blank lines of the puzzle input for both parts.
+func (d *day{{printf "%02d" .Day}}) SetInput(lines []string) {
+	d.lines = d.lines[:0]
+
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		d.lines = append(d.lines, line)
+	}
+}
+
+// Parse is the strict counterpart of SetInput and reports the first malformed
+// line as a *ParseError. Well-formed input must be read exactly as SetInput
+// reads it.
+func (d *day{{printf "%02d" .Day}}) Parse(lines []string) error {
+	d.lines = d.lines[:0]
+
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		// Reject a malformed line here with parseErrorf(lineNo, col, ...).
+		d.lines = append(d.lines, line)
+	}
+	return nil
+}
+
+// SolvePart1 is not solved yet and returns a placeholder.
+func (d *day{{printf "%02d" .Day}}) SolvePart1() string {
+	return "0"
+}
+
+// SolvePart2 is not solved yet and returns a placeholder.
+func (d *day{{printf "%02d" .Day}}) SolvePart2() string {
+	return "0"
+}
+`))
+
+var dayTestTemplate = template.Must(template.New("day_test").Parse(`package days
+
+import "testing"
+
+// Puzzle examples saved by "aoc2025 examples {{.Day}}" are checked by
+// TestExamples.
+
+func BenchmarkDay{{printf "%02d" .Day}}(b *testing.B) {
+	benchmarkDay(b, {{.Day}}, func() Solution { return &day{{printf "%02d" .Day}}{} })
+}
+`))
+
+// runNew implements "aoc2025 new [--year YYYY] [--fetch] [--author NAME]
+// <day>": it writes days/dayNN.go and days/dayNN_test.go from templates and
+// adds a problems.yaml entry for the day. Existing files are never
+// overwritten. With --fetch the title and description come from the puzzle
+// page. It returns the process exit code.
+//
+// Package days holds the days.DefaultYear solvers, whose dayNN type names
+// would clash with another year's, so --year only accepts that year.
+func runNew(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	if year != days.DefaultYear {
+		fmt.Printf("new only scaffolds %d days; solvers for %d need a package of their own\n", days.DefaultYear, year)
+		return 1
+	}
+
+	s := scaffold{Author: defaultAuthor(year)}
+	fetch := false
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; arg {
+		case "--fetch":
+			fetch = true
+		case "--author":
+			if i+1 >= len(args) {
+				fmt.Println("--author requires a value")
+				return 1
+			}
+			i++
+			s.Author = args[i]
+		default:
+			day, err := strconv.Atoi(arg)
+			if err != nil || day < 1 || day > 25 || s.Day != 0 {
+				fmt.Println("Usage: ./aoc2025 new [--year YYYY] [--fetch] [--author NAME] <day>")
+				return 1
+			}
+			s.Day = day
+		}
+	}
+	if s.Day == 0 {
+		fmt.Println("Usage: ./aoc2025 new [--year YYYY] [--fetch] [--author NAME] <day>")
+		return 1
+	}
+	if _, ok := days.Lookup(year, s.Day); ok {
+		fmt.Printf("Day %d is already registered\n", s.Day)
+		return 1
+	}
+
+	problem := problemDescription{URL: fmt.Sprintf("https://adventofcode.com/%d/day/%d", year, s.Day)}
+	if fetch {
+		puzzle, err := aocClient(year).FetchPuzzle(s.Day, sessionToken())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not fetch the title: %s\n", describeFetchError(err))
+		} else {
+			s.Title = puzzle.Title
+			problem.Title = puzzle.Title
+			problem.Description = puzzle.Description
+		}
+	}
+
+	created, err := scaffoldDay(daysDir, s)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+	for _, path := range created {
+		fmt.Printf("Created %s\n", path)
+	}
+
+	path := yearFile(problemsFile, year)
+	added, err := addProblemStub(path, s.Day, problem)
+	if err != nil {
+		fmt.Printf("Error updating %s: %v\n", path, err)
+		return 1
+	}
+	if added {
+		fmt.Printf("Added day %d to %s\n", s.Day, path)
+	}
+	return 0
+}
+
+// scaffoldDay renders the day and test templates for s into dir and returns
+// the paths written. It refuses to start when either file already exists, so
+// a partly written day is never clobbered.
+func scaffoldDay(dir string, s scaffold) ([]string, error) {
+	files := []struct {
+		path string
+		tmpl *template.Template
+	}{
+		{filepath.Join(dir, fmt.Sprintf("day%02d.go", s.Day)), dayTemplate},
+		{filepath.Join(dir, fmt.Sprintf("day%02d_test.go", s.Day)), dayTestTemplate},
+	}
+
+	for _, f := range files {
+		if _, err := os.Stat(f.path); err == nil {
+			return nil, fmt.Errorf("%s already exists; not overwriting", f.path)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	var created []string
+	for _, f := range files {
+		var buf bytes.Buffer
+		if err := f.tmpl.Execute(&buf, s); err != nil {
+			return created, err
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return created, fmt.Errorf("formatting %s: %w", f.path, err)
+		}
+
+		out, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err != nil {
+			return created, err
+		}
+		_, err = out.Write(src)
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return created, err
+		}
+		created = append(created, f.path)
+	}
+	return created, nil
+}
+
+// addProblemStub adds problem as the entry for day in the problems file at
+// path, creating the file if needed. An existing entry is left alone, in
+// which case it reports false.
+func addProblemStub(path string, day int, problem problemDescription) (bool, error) {
+	descriptions, err := LoadProblemDescriptions(path)
+	if errors.Is(err, os.ErrNotExist) {
+		descriptions = map[int]problemDescription{}
+	} else if err != nil {
+		return false, err
+	}
+	if _, ok := descriptions[day]; ok {
+		return false, nil
+	}
+
+	descriptions[day] = problem
+	return true, SaveProblemDescriptions(path, descriptions)
+}
+
+// defaultAuthor returns the author of the latest registered day of year, so
+// new days follow whoever has been writing the others.
+func defaultAuthor(year int) string {
+	author := ""
+	for _, info := range days.List() {
+		if info.Year == year && info.Author != "" {
+			author = info.Author
+		}
+	}
+	return author
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScaffoldDay(t *testing.T) {
+	dir := t.TempDir()
+	s := scaffold{Day: 7, Title: `Bridge "Repair"`, Author: "someone"}
+
+	created, err := scaffoldDay(dir, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 2 {
+		t.Fatalf("created %v, want the day and its test", created)
+	}
+
+	src, err := os.ReadFile(filepath.Join(dir, "day07.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"type day07 struct", "Day:    7,", `Title:  "Bridge \"Repair\"",`, "func (d *day07) Parse(lines []string) error"} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("day07.go lacks %q:\n%s", want, src)
+		}
+	}
+
+	if strings.Contains(string(src), "strict bool") {
+		t.Errorf("day07.go still threads a strict flag:\n%s", src)
+	}
+
+	test, err := os.ReadFile(filepath.Join(dir, "day07_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(test), "benchmarkDay(b, 7, func() Solution { return &day07{} })") {
+		t.Errorf("day07_test.go lacks the benchmark:\n%s", test)
+	}
+}
+
+func TestScaffoldDayRefusesToOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	testPath := filepath.Join(dir, "day03_test.go")
+	if err := os.WriteFile(testPath, []byte("package days\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := scaffoldDay(dir, scaffold{Day: 3}); err == nil {
+		t.Fatal("scaffoldDay: expected an error for an existing file")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "day03.go")); !os.IsNotExist(err) {
+		t.Fatalf("day03.go was written despite the conflict: %v", err)
+	}
+	if data, _ := os.ReadFile(testPath); string(data) != "package days\n" {
+		t.Fatalf("existing test file was modified: %q", data)
+	}
+}
+
+func TestNewRejectsOtherYears(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if code := runNew([]string{"--year", "2024", "7"}); code == 0 {
+		t.Fatal("runNew --year 2024: want a non-zero exit code")
+	}
+	if entries, _ := os.ReadDir("."); len(entries) != 0 {
+		t.Fatalf("runNew --year 2024 wrote %d files", len(entries))
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// reporter renders day results as they arrive and flushes anything it buffered
+// when Close is called.
+type reporter interface {
+	Report(r dayResult)
+	Close() error
+}
+
+// newReporter returns the reporter for format, one of "text", "json" or
+// "ndjson". Titles for the machine-readable formats come from descriptions;
+// the text format also honours the verbose and timing options.
+func newReporter(format string, w io.Writer, opts options, descriptions map[int]problemDescription) (reporter, error) {
+	switch format {
+	case "", "text":
+		return &textReporter{w: w, verbose: opts.verbose, timing: opts.timing, descriptions: descriptions}, nil
+	case "json":
+		return &jsonReporter{w: w, descriptions: descriptions, results: []jsonDayResult{}}, nil
+	case "ndjson":
+		return &ndjsonReporter{enc: json.NewEncoder(w), descriptions: descriptions}, nil
+	default:
+		return nil, fmt.Errorf("unknown format: %s (want text, json or ndjson)", format)
+	}
+}
+
+// textReporter prints the human-readable per-day output and, when timing is
+// enabled, a phase timing summary once all days are done.
+type textReporter struct {
+	w            io.Writer
+	verbose      bool
+	timing       bool
+	descriptions map[int]problemDescription
+	timings      []timingRow
+}
+
+// Report writes one day's header and answers, or the error that kept the day
+// from being solved.
+func (t *textReporter) Report(r dayResult) {
+	if r.Err != nil {
+		if r.Check != "" {
+			fmt.Fprintf(t.w, "%v (%s)\n", r.Err, r.Check)
+		} else {
+			fmt.Fprintln(t.w, r.Err)
+		}
+		return
+	}
+
+	fmt.Fprintf(t.w, "🌟 Day %d 🌟\n", r.Day)
+	if t.verbose {
+		printProblemDescription(t.w, r.Day, t.descriptions)
+	}
+	t.printPart(1, r.Part1)
+	t.printPart(2, r.Part2)
+	fmt.Fprintln(t.w)
+
+	if t.timing {
+		t.timings = append(t.timings, timingRowFor(r))
+	}
+}
+
+// printPart prints either a part's answer or the error that stopped it,
+// followed by the --check verdict when there is one. Parts left out by --part
+// print nothing.
+func (t *textReporter) printPart(part int, outcome partOutcome) {
+	if outcome.NotImplemented {
+		fmt.Fprintf(t.w, "Part %d: not implemented\n", part)
+		return
+	}
+	if outcome.Skipped {
+		return
+	}
+
+	verdict := ""
+	switch outcome.Check {
+	case "":
+	case checkFail:
+		verdict = fmt.Sprintf(" (%s)", outcome.Check)
+		if outcome.Expected != "" {
+			verdict = fmt.Sprintf(" (%s, expected %s)", outcome.Check, outcome.Expected)
+		}
+	default:
+		verdict = fmt.Sprintf(" (%s)", outcome.Check)
+	}
+
+	if outcome.Err != nil {
+		fmt.Fprintf(t.w, "Part %d: error: %v%s\n", part, outcome.Err, verdict)
+		return
+	}
+	fmt.Fprintf(t.w, "Part %d: %s%s\n", part, outcome.Answer, verdict)
+}
+
+// Close prints the timing summary when timing is enabled.
+func (t *textReporter) Close() error {
+	if t.timing && len(t.timings) > 0 {
+		writeTimingTable(t.w, phaseParse, t.timings)
+	}
+	return nil
+}
+
+// jsonPart is the machine-readable form of a partOutcome.
+type jsonPart struct {
+	Answer         string `json:"answer"`
+	NotImplemented bool   `json:"not_implemented,omitempty"`
+	Error          string `json:"error,omitempty"`
+	DurationNS     int64  `json:"duration_ns"`
+	Check          string `json:"check,omitempty"`
+	Expected       string `json:"expected,omitempty"`
+}
+
+// jsonDayResult is the machine-readable form of a dayResult. Parts are omitted
+// when the day failed before solving started or were not selected.
+type jsonDayResult struct {
+	Year            int       `json:"year"`
+	Day             int       `json:"day"`
+	Title           string    `json:"title,omitempty"`
+	ParseDurationNS int64     `json:"parse_duration_ns"`
+	Part1           *jsonPart `json:"part1,omitempty"`
+	Part2           *jsonPart `json:"part2,omitempty"`
+	Error           string    `json:"error,omitempty"`
+	Check           string    `json:"check,omitempty"`
+}
+
+// toJSON converts r into its serialisable form, looking up the day title in
+// descriptions.
+func toJSON(r dayResult, descriptions map[int]problemDescription) jsonDayResult {
+	out := jsonDayResult{
+		Year:            r.Year,
+		Day:             r.Day,
+		Title:           descriptions[r.Day].Title,
+		ParseDurationNS: r.Parse.Nanoseconds(),
+	}
+	if r.Err != nil {
+		out.Error = r.Err.Error()
+		out.Check = r.Check
+		return out
+	}
+	out.Part1 = toJSONPart(r.Part1)
+	out.Part2 = toJSONPart(r.Part2)
+	return out
+}
+
+func toJSONPart(p partOutcome) *jsonPart {
+	if p.NotImplemented {
+		return &jsonPart{NotImplemented: true}
+	}
+	if p.Skipped {
+		return nil
+	}
+	out := &jsonPart{
+		Answer:     p.Answer,
+		DurationNS: p.Duration.Nanoseconds(),
+		Check:      p.Check,
+		Expected:   p.Expected,
+	}
+	if p.Err != nil {
+		out.Error = p.Err.Error()
+	}
+	return out
+}
+
+// fromJSON is the inverse of toJSON, used to read results produced by another
+// aoc2025 process. Error texts come back as plain errors.
+func fromJSON(j jsonDayResult) dayResult {
+	r := dayResult{Year: j.Year, Day: j.Day, Parse: time.Duration(j.ParseDurationNS)}
+	if j.Error != "" {
+		r.Err = errors.New(j.Error)
+		r.Check = j.Check
+		return r
+	}
+	r.Part1 = fromJSONPart(j.Part1)
+	r.Part2 = fromJSONPart(j.Part2)
+	return r
+}
+
+func fromJSONPart(p *jsonPart) partOutcome {
+	if p == nil {
+		return partOutcome{Skipped: true}
+	}
+	if p.NotImplemented {
+		return partOutcome{Skipped: true, NotImplemented: true}
+	}
+	out := partOutcome{
+		Answer:   p.Answer,
+		Duration: time.Duration(p.DurationNS),
+		Check:    p.Check,
+		Expected: p.Expected,
+	}
+	if p.Error != "" {
+		out.Err = errors.New(p.Error)
+	}
+	return out
+}
+
+// jsonReporter collects every result and writes a single JSON array on Close.
+type jsonReporter struct {
+	w            io.Writer
+	descriptions map[int]problemDescription
+	results      []jsonDayResult
+}
+
+func (j *jsonReporter) Report(r dayResult) {
+	j.results = append(j.results, toJSON(r, j.descriptions))
+}
+
+func (j *jsonReporter) Close() error {
+	enc := json.NewEncoder(j.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(j.results)
+}
+
+// ndjsonReporter writes one JSON object per line as soon as each day is done.
+// The first write error, such as a closed pipe, is kept and returned by Close.
+type ndjsonReporter struct {
+	enc          *json.Encoder
+	descriptions map[int]problemDescription
+	err          error
+}
+
+func (n *ndjsonReporter) Report(r dayResult) {
+	if err := n.enc.Encode(toJSON(r, n.descriptions)); err != nil && n.err == nil {
+		n.err = err
+	}
+}
+
+func (n *ndjsonReporter) Close() error { return n.err }
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewReporterRejectsUnknownFormat(t *testing.T) {
+	if _, err := newReporter("yaml", &strings.Builder{}, options{}, nil); err == nil || !strings.Contains(err.Error(), "unknown format: yaml") {
+		t.Fatalf("newReporter(yaml) error = %v", err)
+	}
+}
+
+// reporterResults is what the json and ndjson tests report: a solved day with
+// part 2 left out and a day that failed to parse.
+var reporterResults = []dayResult{
+	{
+		Year:  2025,
+		Day:   1,
+		Parse: 3 * time.Microsecond,
+		Part1: partOutcome{Answer: "3", Duration: 5 * time.Microsecond, Check: checkPass},
+		Part2: partOutcome{Skipped: true},
+	},
+	{Year: 2025, Day: 2, Err: errors.New("Error parsing input for day 2: line 1, column 7: range \"95\" is missing '-'")},
+}
+
+var reporterDescriptions = map[int]problemDescription{1: {Title: "Secret Entrance"}}
+
+func checkReporterJSON(t *testing.T, got []jsonDayResult) {
+	t.Helper()
+	if len(got) != 2 {
+		t.Fatalf("got %d results, want 2", len(got))
+	}
+	if d := got[0]; d.Day != 1 || d.Title != "Secret Entrance" || d.ParseDurationNS != 3000 ||
+		d.Part1 == nil || d.Part1.Answer != "3" || d.Part1.Check != checkPass || d.Part2 != nil || d.Error != "" {
+		t.Errorf("day 1: got %+v", d)
+	}
+	if d := got[1]; d.Day != 2 || d.Part1 != nil || d.Part2 != nil || !strings.Contains(d.Error, "line 1, column 7") {
+		t.Errorf("day 2: got %+v", d)
+	}
+}
+
+func TestJSONReporter(t *testing.T) {
+	var b strings.Builder
+	rep, err := newReporter("json", &b, options{}, reporterDescriptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range reporterResults {
+		rep.Report(r)
+	}
+	if b.Len() != 0 {
+		t.Fatalf("json wrote before Close: %s", b.String())
+	}
+	if err := rep.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []jsonDayResult
+	if err := json.Unmarshal([]byte(b.String()), &got); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, b.String())
+	}
+	checkReporterJSON(t, got)
+}
+
+func TestNDJSONReporter(t *testing.T) {
+	var b strings.Builder
+	rep, err := newReporter("ndjson", &b, options{}, reporterDescriptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rep.Report(reporterResults[0])
+	if !strings.HasSuffix(b.String(), "\n") || strings.Count(b.String(), "\n") != 1 {
+		t.Fatalf("ndjson did not write the first day as one line right away: %q", b.String())
+	}
+	rep.Report(reporterResults[1])
+	if err := rep.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []jsonDayResult
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n") {
+		var r jsonDayResult
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("line is not a JSON object: %v\n%s", err, line)
+		}
+		got = append(got, r)
+	}
+	checkReporterJSON(t, got)
+}
+
+// failingWriter fails every write, like a pipe whose reader has gone away.
+type failingWriter struct{ writes int }
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return 0, errors.New("broken pipe")
+}
+
+func TestNDJSONReporterReportsWriteErrors(t *testing.T) {
+	w := &failingWriter{}
+	rep, err := newReporter("ndjson", w, options{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range reporterResults {
+		rep.Report(r)
+	}
+	if w.writes == 0 {
+		t.Fatal("ndjson never wrote")
+	}
+	if err := rep.Close(); err == nil || !strings.Contains(err.Error(), "broken pipe") {
+		t.Fatalf("Close error = %v, want the write error", err)
+	}
+}
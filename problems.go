@@ -1,72 +1,281 @@
 package main
 
 import (
-	"bufio"
+	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+
+	"aoc2025/days"
+	"aoc2025/miniyaml"
 )
 
 type problemDescription struct {
 	Title       string
 	Description string
+	URL         string
+	Tags        []string
+	Difficulty  string
+	Examples    []problemExample
+}
+
+// problemExample is a worked example listed in problems.yaml with the answers
+// the puzzle text gives for it; an empty answer means none is stated.
+type problemExample struct {
+	Input string
+	Part1 string
+	Part2 string
 }
 
-// LoadProblemDescriptions reads the small problems.yaml file and returns day
-// descriptions keyed by day number. It supports the simple shape used in this
-// repository: day numbers at the top level with title and description fields.
+// LoadProblemDescriptions reads problems.yaml and returns day descriptions
+// keyed by day number. Day numbers are the top-level keys; each day may set
+// title, description, url, tags, difficulty and examples. Malformed YAML,
+// unknown keys and values of the wrong shape are reported with their line
+// number.
 func LoadProblemDescriptions(path string) (map[int]problemDescription, error) {
-	f, err := os.Open(path)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
 	descriptions := make(map[int]problemDescription)
-	currentDay := 0
+	err = forEachDay(data, func(day int, node *miniyaml.Node) error {
+		var problem problemDescription
+		for _, pair := range node.Pairs {
+			var err error
+			switch pair.Key {
+			case "title":
+				problem.Title, err = yamlScalar(pair)
+			case "description":
+				problem.Description, err = yamlScalar(pair)
+			case "url":
+				problem.URL, err = yamlScalar(pair)
+			case "difficulty":
+				problem.Difficulty, err = yamlScalar(pair)
+			case "tags":
+				problem.Tags, err = yamlScalarList(pair)
+			case "examples":
+				problem.Examples, err = parseProblemExamples(pair)
+			default:
+				err = miniyaml.Errorf(pair.KeyLine, "unknown key %q", pair.Key)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		descriptions[day] = problem
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return descriptions, nil
+}
 
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
+// parseProblemExamples reads the examples list: a sequence of mappings with
+// input, part1 and part2 keys.
+func parseProblemExamples(pair miniyaml.Pair) ([]problemExample, error) {
+	if pair.Value.Kind == miniyaml.ScalarNode && pair.Value.Value == "" {
+		return nil, nil
+	}
+	if pair.Value.Kind != miniyaml.SequenceNode {
+		return nil, miniyaml.Errorf(pair.Value.Line, "%s must be a list", pair.Key)
+	}
+
+	var examples []problemExample
+	for _, item := range pair.Value.Items {
+		if item.Kind != miniyaml.MappingNode {
+			return nil, miniyaml.Errorf(item.Line, "each example must be a mapping")
 		}
 
-		if strings.HasSuffix(line, ":") {
-			day, err := strconv.Atoi(strings.TrimSuffix(line, ":"))
+		var ex problemExample
+		for _, field := range item.Pairs {
+			var err error
+			switch field.Key {
+			case "input":
+				ex.Input, err = yamlScalar(field)
+			case "part1":
+				ex.Part1, err = yamlScalar(field)
+			case "part2":
+				ex.Part2, err = yamlScalar(field)
+			default:
+				err = miniyaml.Errorf(field.KeyLine, "unknown example key %q", field.Key)
+			}
 			if err != nil {
-				currentDay = 0
-				continue
+				return nil, err
 			}
-			currentDay = day
-			descriptions[currentDay] = problemDescription{}
-			continue
+		}
+		examples = append(examples, ex)
+	}
+	return examples, nil
+}
+
+// forEachDay parses a per-day data file such as problems.yaml or answers.yaml
+// and calls fn with each day number and its mapping. An empty day entry is
+// passed as an empty mapping.
+func forEachDay(data []byte, fn func(day int, node *miniyaml.Node) error) error {
+	root, err := miniyaml.Parse(data)
+	if err != nil {
+		return err
+	}
+	if root.Kind != miniyaml.MappingNode {
+		return miniyaml.Errorf(root.Line, "top level must map day numbers to entries")
+	}
+
+	for _, pair := range root.Pairs {
+		day, err := strconv.Atoi(pair.Key)
+		if err != nil || day < 1 {
+			return miniyaml.Errorf(pair.KeyLine, "invalid day number %q", pair.Key)
+		}
+
+		node := pair.Value
+		switch {
+		case node.Kind == miniyaml.ScalarNode && node.Value == "":
+			node = &miniyaml.Node{Kind: miniyaml.MappingNode, Line: node.Line}
+		case node.Kind != miniyaml.MappingNode:
+			return miniyaml.Errorf(node.Line, "day %d must be a mapping", day)
 		}
 
-		if currentDay == 0 {
-			continue
+		if err := fn(day, node); err != nil {
+			return err
 		}
+	}
+	return nil
+}
+
+// yamlScalar returns the value of pair, which must be a scalar.
+func yamlScalar(pair miniyaml.Pair) (string, error) {
+	if pair.Value.Kind != miniyaml.ScalarNode {
+		return "", miniyaml.Errorf(pair.Value.Line, "%s must be a single value, not a %s", pair.Key, pair.Value.Kind)
+	}
+	return pair.Value.Value, nil
+}
+
+// yamlScalarList returns the items of pair, which must be a sequence of
+// scalars.
+func yamlScalarList(pair miniyaml.Pair) ([]string, error) {
+	if pair.Value.Kind == miniyaml.ScalarNode && pair.Value.Value == "" {
+		return nil, nil
+	}
+	if pair.Value.Kind != miniyaml.SequenceNode {
+		return nil, miniyaml.Errorf(pair.Value.Line, "%s must be a list", pair.Key)
+	}
 
-		key, value, ok := strings.Cut(line, ":")
-		if !ok {
-			continue
+	values := make([]string, 0, len(pair.Value.Items))
+	for _, item := range pair.Value.Items {
+		if item.Kind != miniyaml.ScalarNode {
+			return nil, miniyaml.Errorf(item.Line, "%s entries must be single values", pair.Key)
 		}
+		values = append(values, item.Value)
+	}
+	return values, nil
+}
 
-		problem := descriptions[currentDay]
-		switch strings.TrimSpace(key) {
-		case "title":
-			problem.Title = yamlString(value)
-		case "description":
-			problem.Description = yamlString(value)
+// dataDir returns the directory holding problems.yaml and answers.yaml:
+// $AOC_DATA_DIR when set, so the binary can run from any directory, and the
+// working directory otherwise.
+func dataDir() string {
+	if dir := os.Getenv("AOC_DATA_DIR"); dir != "" {
+		return dir
+	}
+	return "."
+}
+
+// yearFile returns the path of the per-year variant of a data file such as
+// problems.yaml under dataDir: the name itself for days.DefaultYear and
+// "problems-YYYY.yaml" otherwise.
+func yearFile(name string, year int) string {
+	if year != days.DefaultYear {
+		ext := filepath.Ext(name)
+		name = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), year, ext)
+	}
+	return filepath.Join(dataDir(), name)
+}
+
+// SaveProblemDescriptions writes descriptions to path in day order using the
+// same shape LoadProblemDescriptions reads, replacing the file. Optional
+// fields are only written when set.
+func SaveProblemDescriptions(path string, descriptions map[int]problemDescription) error {
+	dayNumbers := make([]int, 0, len(descriptions))
+	for day := range descriptions {
+		dayNumbers = append(dayNumbers, day)
+	}
+	slices.Sort(dayNumbers)
+
+	var b strings.Builder
+	for _, day := range dayNumbers {
+		problem := descriptions[day]
+		fmt.Fprintf(&b, "%d:\n", day)
+		fmt.Fprintf(&b, "  title: %s\n", yamlQuote(problem.Title))
+		fmt.Fprintf(&b, "  description: %s\n", yamlQuote(problem.Description))
+		if problem.URL != "" {
+			fmt.Fprintf(&b, "  url: %s\n", yamlQuote(problem.URL))
+		}
+		if len(problem.Tags) > 0 {
+			quoted := make([]string, len(problem.Tags))
+			for i, tag := range problem.Tags {
+				quoted[i] = yamlQuote(tag)
+			}
+			fmt.Fprintf(&b, "  tags: [%s]\n", strings.Join(quoted, ", "))
+		}
+		if problem.Difficulty != "" {
+			fmt.Fprintf(&b, "  difficulty: %s\n", yamlQuote(problem.Difficulty))
+		}
+		if len(problem.Examples) > 0 {
+			b.WriteString("  examples:\n")
+			for _, ex := range problem.Examples {
+				fmt.Fprintf(&b, "    - input: %s\n", yamlBlock(ex.Input, "        "))
+				if ex.Part1 != "" {
+					fmt.Fprintf(&b, "      part1: %s\n", yamlQuote(ex.Part1))
+				}
+				if ex.Part2 != "" {
+					fmt.Fprintf(&b, "      part2: %s\n", yamlQuote(ex.Part2))
+				}
+			}
 		}
-		descriptions[currentDay] = problem
 	}
 
-	return descriptions, scanner.Err()
+	return os.WriteFile(path, []byte(b.String()), 0644)
 }
 
-func yamlString(value string) string {
-	value = strings.TrimSpace(value)
-	value = strings.Trim(value, "\"")
-	return value
+// yamlQuote renders value as a double-quoted scalar. Go's quoting produces
+// only escapes that YAML double-quoted strings also understand.
+func yamlQuote(value string) string {
+	return strconv.Quote(value)
+}
+
+// yamlBlock renders multi-line text as a literal block scalar indented by
+// indent, falling back to a quoted scalar when a block cannot represent the
+// text exactly: single lines, a leading space, extra trailing newlines, and
+// whitespace-only lines, which a block reads back as empty.
+func yamlBlock(text, indent string) string {
+	if !strings.Contains(text, "\n") || strings.HasPrefix(text, " ") || strings.HasSuffix(text, "\n\n") {
+		return yamlQuote(text)
+	}
+	for line := range strings.SplitSeq(text, "\n") {
+		if line != "" && strings.TrimSpace(line) == "" {
+			return yamlQuote(text)
+		}
+	}
+
+	header := "|"
+	body := text
+	if strings.HasSuffix(text, "\n") {
+		body = strings.TrimSuffix(text, "\n")
+	} else {
+		header = "|-"
+	}
+
+	var b strings.Builder
+	b.WriteString(header)
+	for line := range strings.SplitSeq(body, "\n") {
+		b.WriteString("\n")
+		if line != "" {
+			b.WriteString(indent + line)
+		}
+	}
+	return b.String()
 }
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"aoc2025/days"
+)
+
+func TestYearFile(t *testing.T) {
+	t.Setenv("AOC_DATA_DIR", "")
+	if got := yearFile(problemsFile, days.DefaultYear); got != "problems.yaml" {
+		t.Errorf("default year: got %s, want problems.yaml", got)
+	}
+	if got := yearFile(answersFile, 2024); got != "answers-2024.yaml" {
+		t.Errorf("2024: got %s, want answers-2024.yaml", got)
+	}
+
+	t.Setenv("AOC_DATA_DIR", filepath.Join("home", "aoc"))
+	if got, want := yearFile(problemsFile, 2024), filepath.Join("home", "aoc", "problems-2024.yaml"); got != want {
+		t.Errorf("with AOC_DATA_DIR: got %s, want %s", got, want)
+	}
+}
+
+func TestLoadProblemDescriptions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "problems.yaml")
+	data := `1:
+  title: "Secret Entrance"
+  description: Count the dial positions.
+  url: https://adventofcode.com/2025/day/1
+  tags: [simulation, "modular arithmetic"]
+  difficulty: easy
+  examples:
+    - input: |
+        L68
+        L30
+      part1: "3"
+    - input: "R5"
+      part2: "1"
+2:
+`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := LoadProblemDescriptions(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]problemDescription{
+		1: {
+			Title:       "Secret Entrance",
+			Description: "Count the dial positions.",
+			URL:         "https://adventofcode.com/2025/day/1",
+			Tags:        []string{"simulation", "modular arithmetic"},
+			Difficulty:  "easy",
+			Examples: []problemExample{
+				{Input: "L68\nL30\n", Part1: "3"},
+				{Input: "R5", Part2: "1"},
+			},
+		},
+		2: {},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("LoadProblemDescriptions:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestLoadProblemDescriptionsErrors(t *testing.T) {
+	tests := []struct {
+		name, data, want string
+	}{
+		{"unknown key", "1:\n  title: a\n  tag: b\n", `line 3: unknown key "tag"`},
+		{"invalid day", "one:\n  title: a\n", `line 1: invalid day number "one"`},
+		{"day not a mapping", "1: a\n", "line 1: day 1 must be a mapping"},
+		{"title not a scalar", "1:\n  title: [a, b]\n", "line 2: title must be a single value, not a sequence"},
+		{"tags not a list", "1:\n  tags: a\n", "line 2: tags must be a list"},
+		{"tag not a scalar", "1:\n  tags:\n    - [a]\n", "line 3: tags entries must be single values"},
+		{"examples not a list", "1:\n  examples: a\n", "line 2: examples must be a list"},
+		{"example not a mapping", "1:\n  examples:\n    - a\n", "line 3: each example must be a mapping"},
+		{"unknown example key", "1:\n  examples:\n    - input: a\n      part3: b\n", `line 4: unknown example key "part3"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "problems.yaml")
+			if err := os.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadProblemDescriptions(path)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestProblemDescriptionsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "problems.yaml")
+	want := map[int]problemDescription{
+		1: {Title: "Secret Entrance", Description: `Quotes " and \\ backslashes`},
+		5: {
+			Title:       "Cafeteria",
+			Description: "Fresh ingredients.",
+			URL:         "https://adventofcode.com/2025/day/5",
+			Tags:        []string{"intervals", "sorting"},
+			Difficulty:  "medium",
+			Examples: []problemExample{
+				{Input: "3-5\n10-14\n\n1\n5\n", Part1: "3", Part2: "14"},
+				{Input: "no trailing newline\nsecond", Part1: "0"},
+				{Input: "a\n   \nb\n"},
+				{Input: "  leading spaces\nx\n"},
+				{Input: "extra newlines\n\n"},
+				{Input: "single line"},
+				{Input: "x\n  indented\n\ty\n"},
+			},
+		},
+	}
+
+	if err := SaveProblemDescriptions(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadProblemDescriptions(path)
+	if err != nil {
+		data, _ := os.ReadFile(path)
+		t.Fatalf("%v\n%s", err, data)
+	}
+	if !reflect.DeepEqual(got, want) {
+		data, _ := os.ReadFile(path)
+		t.Fatalf("round trip:\n got %+v\nwant %+v\nfile:\n%s", got, want, data)
+	}
+}
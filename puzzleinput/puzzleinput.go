@@ -0,0 +1,63 @@
+// Package puzzleinput turns raw puzzle input into the lines handed to the
+// solvers. The CLI, the network client and the benchmarks all read input
+// through it, so a file gives the same lines no matter where it came from.
+//
+// The normalization contract:
+//
+//   - A leading UTF-8 byte order mark is removed.
+//   - Lines end at "\n"; a "\r" before it is dropped, so CRLF files read the
+//     same as LF files. A lone "\r" elsewhere is kept.
+//   - Empty lines at the end of the input are dropped, including the one a
+//     final newline would otherwise produce. Empty lines before and between
+//     other lines are kept, since several puzzles use them as separators.
+//   - Every other byte is kept as is: leading and trailing spaces on a line
+//     are significant for grid puzzles.
+//   - Lines may be of any length.
+//
+// Empty input, or input of only blank lines, yields no lines.
+package puzzleinput
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+)
+
+const bom = "\uFEFF"
+
+// Split normalizes data as described in the package documentation and
+// returns its lines.
+func Split(data []byte) []string {
+	data = bytes.TrimPrefix(data, []byte(bom))
+
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return lines
+}
+
+// Read reads r to the end and returns its normalized lines.
+func Read(r io.Reader) ([]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Split(data), nil
+}
+
+// ReadFile reads the file at path and returns its normalized lines.
+func ReadFile(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Split(data), nil
+}
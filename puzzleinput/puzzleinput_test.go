@@ -0,0 +1,85 @@
+package puzzleinput
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"only newlines", "\n\n\r\n", nil},
+		{"no final newline", "a\nb", []string{"a", "b"}},
+		{"final newline", "a\nb\n", []string{"a", "b"}},
+		{"crlf", "a\r\nb\r\n", []string{"a", "b"}},
+		{"mixed endings", "a\r\nb\nc", []string{"a", "b", "c"}},
+		{"lone cr kept", "a\rb\n", []string{"a\rb"}},
+		{"bom", "\uFEFFL68\nR8\n", []string{"L68", "R8"}},
+		{"bom only at start", "a\n\uFEFFb\n", []string{"a", "\uFEFFb"}},
+		{"inner blank lines kept", "3-5\n\n1\n", []string{"3-5", "", "1"}},
+		{"leading blank lines kept", "\n0:\n#\n", []string{"", "0:", "#"}},
+		{"trailing blank lines dropped", "a\n\n\n", []string{"a"}},
+		{"trailing crlf blank lines dropped", "a\r\n\r\n", []string{"a"}},
+		{"spaces kept", " 12 \n*   \n", []string{" 12 ", "*   "}},
+		{"whitespace-only last line kept", "a\n  \n", []string{"a", "  "}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Split([]byte(tt.in)); !slices.Equal(got, tt.want) {
+				t.Fatalf("Split(%q): got %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitLongLine(t *testing.T) {
+	long := strings.Repeat("9", 1<<20)
+	got := Split([]byte("1\n" + long + "\n2\n"))
+	if len(got) != 3 || got[1] != long {
+		t.Fatalf("Split lost the long line: got %d lines", len(got))
+	}
+}
+
+func TestRead(t *testing.T) {
+	got, err := Read(iotest.OneByteReader(strings.NewReader("a\r\nb\r\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, []string{"a", "b"}) {
+		t.Fatalf("Read: got %q", got)
+	}
+
+	boom := errors.New("boom")
+	if _, err := Read(iotest.ErrReader(boom)); !errors.Is(err, boom) {
+		t.Fatalf("Read: got %v, want the reader's error", err)
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "day01.txt")
+	if err := os.WriteFile(path, []byte("\uFEFFL68\r\nR8\r\n\r\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, []string{"L68", "R8"}) {
+		t.Fatalf("ReadFile: got %q", got)
+	}
+
+	if _, err := ReadFile(filepath.Join(t.TempDir(), "missing.txt")); !os.IsNotExist(err) {
+		t.Fatalf("ReadFile: got %v, want a not-exist error", err)
+	}
+}
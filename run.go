@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"aoc2025/days"
+)
+
+// partOutcome is the answer or error produced by solving one part of a day.
+// Skipped marks a part that was not solved, either because --part left it out
+// or because NotImplemented says the solver has no real answer for it. Check
+// and Expected are filled in by --check.
+type partOutcome struct {
+	Skipped        bool
+	NotImplemented bool
+	Answer         string
+	Err            error
+	Duration       time.Duration
+	Check          string
+	Expected       string
+}
+
+// dayResult collects everything the CLI reports for one requested day. Err is
+// set when the day could not be solved at all, for example because its input
+// failed to load or parse; per-part failures are recorded on Part1 and Part2.
+// Check is the --check verdict for a day that failed as a whole.
+type dayResult struct {
+	Year  int
+	Day   int
+	Parse time.Duration
+	Part1 partOutcome
+	Part2 partOutcome
+	Err   error
+	Check string
+}
+
+// solveDay loads the input for day of year through load, parses it with a
+// fresh solver instance and solves the selected part under ctx; part 0 solves
+// both.
+func solveDay(ctx context.Context, year, day, part int, load inputLoader) dayResult {
+	solver, ok := days.GetSolverYear(year, day)
+	if !ok {
+		return dayResult{Year: year, Day: day, Err: fmt.Errorf("No solver for day %d", day)}
+	}
+	return solveWith(ctx, solver, year, day, part, load)
+}
+
+// solveWith is solveDay for a solver the caller already holds, so it can wait
+// for abandoned work with days.Wait afterwards.
+func solveWith(ctx context.Context, solver days.Solver, year, day, part int, load inputLoader) dayResult {
+	result := dayResult{Year: year, Day: day}
+
+	lines, err := load(year, day)
+	if err != nil {
+		result.Err = fmt.Errorf("Error loading input for day %d: %w", day, err)
+		return result
+	}
+
+	start := time.Now()
+	err = solver.Parse(lines)
+	result.Parse = time.Since(start)
+	if err != nil {
+		result.Err = fmt.Errorf("Error parsing input for day %d: %w", day, err)
+		return result
+	}
+
+	result.Part1 = partOutcome{Skipped: true}
+	result.Part2 = partOutcome{Skipped: true}
+	if part != 2 {
+		result.Part1 = solvePart(ctx, solver.Part1)
+	}
+	if part != 1 {
+		if info, _ := days.Lookup(year, day); info.Part2Missing {
+			result.Part2 = partOutcome{Skipped: true, NotImplemented: true}
+		} else {
+			result.Part2 = solvePart(ctx, solver.Part2)
+		}
+	}
+	return result
+}
+
+// solvePart runs solve under ctx and captures its answer or error together
+// with the wall-clock time it took.
+func solvePart(ctx context.Context, solve func(context.Context) (string, error)) partOutcome {
+	start := time.Now()
+	answer, err := solve(ctx)
+	return partOutcome{Answer: answer, Err: err, Duration: time.Since(start)}
+}
+
+// runDays solves the selected part of every day of year in dayNumbers, reading
+// inputs through load, using up to jobs concurrent workers and calls emit with
+// each result in the order the days were requested. Once ctx is cancelled no
+// new days are started and runDays returns after the in-flight days finish.
+func runDays(ctx context.Context, year int, dayNumbers []int, part, jobs int, load inputLoader, emit func(dayResult)) {
+	if jobs < 1 {
+		jobs = 1
+	}
+	jobs = min(jobs, len(dayNumbers))
+
+	results := make([]dayResult, len(dayNumbers))
+	ready := make([]chan struct{}, len(dayNumbers))
+	for i := range ready {
+		ready[i] = make(chan struct{})
+	}
+
+	next := make(chan int)
+	go func() {
+		defer close(next)
+		for i := range dayNumbers {
+			select {
+			case next <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range jobs {
+		wg.Go(func() {
+			for i := range next {
+				results[i] = solveDay(ctx, year, dayNumbers[i], part, load)
+				close(ready[i])
+			}
+		})
+	}
+	defer wg.Wait()
+
+	for i := range dayNumbers {
+		select {
+		case <-ready[i]:
+			emit(results[i])
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"aoc2025/days"
+)
+
+// echoSolution answers both parts with its first input line.
+type echoSolution struct{ answer string }
+
+func (s *echoSolution) SetInput(lines []string) { s.answer = lines[0] }
+func (s *echoSolution) SolvePart1() string      { return s.answer }
+func (s *echoSolution) SolvePart2() string      { return s.answer }
+
+func init() {
+	for day := 1; day <= 6; day++ {
+		days.RegisterYear(1998, day, func() days.Solution { return &echoSolution{} })
+	}
+}
+
+func TestRunDaysEmitsInRequestOrder(t *testing.T) {
+	requested := []int{3, 1, 5, 2, 6, 4}
+
+	// Days requested earlier take longer to load, so with several workers
+	// they finish after the days requested behind them.
+	load := func(year, day int) ([]string, error) {
+		i := slices.Index(requested, day)
+		time.Sleep(time.Duration(len(requested)-i) * 10 * time.Millisecond)
+		return []string{"day" + strconv.Itoa(day)}, nil
+	}
+
+	for _, jobs := range []int{1, 3, len(requested)} {
+		var emitted []int
+		runDays(context.Background(), 1998, requested, 0, jobs, load, func(r dayResult) {
+			if r.Err != nil {
+				t.Errorf("jobs=%d: day %d: %v", jobs, r.Day, r.Err)
+			}
+			if want := "day" + strconv.Itoa(r.Day); r.Part1.Answer != want || r.Part2.Answer != want {
+				t.Errorf("jobs=%d: day %d answered %q/%q, want %q", jobs, r.Day, r.Part1.Answer, r.Part2.Answer, want)
+			}
+			emitted = append(emitted, r.Day)
+		})
+		if !slices.Equal(emitted, requested) {
+			t.Errorf("jobs=%d: emitted %v, want %v", jobs, emitted, requested)
+		}
+	}
+}
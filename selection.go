@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// parseDaySelection expands day specs into day numbers, in the order given.
+// Each spec is "all", a single day ("5"), an inclusive range ("1-5") or a
+// comma-separated list of those ("1-3,7"). Every selected day must be one of
+// the registered days.
+func parseDaySelection(specs []string, registered []int) ([]int, error) {
+	var selected []int
+
+	for _, spec := range specs {
+		for item := range strings.SplitSeq(spec, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+
+			if item == "all" {
+				selected = append(selected, registered...)
+				continue
+			}
+
+			first, last, err := parseDayRange(item)
+			if err != nil {
+				return nil, err
+			}
+			for day := first; day <= last; day++ {
+				if !slices.Contains(registered, day) {
+					return nil, fmt.Errorf("no solver for day %d (available: %s)", day, formatDayList(registered))
+				}
+				selected = append(selected, day)
+			}
+		}
+	}
+
+	return selected, nil
+}
+
+// parseDayRange parses "N" or "N-M" into an inclusive range.
+func parseDayRange(item string) (int, int, error) {
+	firstStr, lastStr, isRange := strings.Cut(item, "-")
+	if !isRange {
+		lastStr = firstStr
+	}
+
+	first, err1 := strconv.Atoi(firstStr)
+	last, err2 := strconv.Atoi(lastStr)
+	if err1 != nil || err2 != nil || first > last {
+		return 0, 0, fmt.Errorf("invalid day: %s", item)
+	}
+	return first, last, nil
+}
+
+// formatDayList renders sorted day numbers compactly, collapsing consecutive
+// runs into ranges: [1 2 3 5] becomes "1-3,5".
+func formatDayList(dayNumbers []int) string {
+	var parts []string
+	for i := 0; i < len(dayNumbers); {
+		j := i
+		for j+1 < len(dayNumbers) && dayNumbers[j+1] == dayNumbers[j]+1 {
+			j++
+		}
+		if i == j {
+			parts = append(parts, strconv.Itoa(dayNumbers[i]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", dayNumbers[i], dayNumbers[j]))
+		}
+		i = j + 1
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseDaySelection(t *testing.T) {
+	registered := []int{1, 2, 3, 4, 5, 7, 20}
+
+	tests := []struct {
+		name    string
+		specs   []string
+		want    []int
+		wantErr bool
+	}{
+		{"single day", []string{"5"}, []int{5}, false},
+		{"several args", []string{"3", "1"}, []int{3, 1}, false},
+		{"range", []string{"2-4"}, []int{2, 3, 4}, false},
+		{"one-day range", []string{"4-4"}, []int{4}, false},
+		{"comma list", []string{"1,3,5"}, []int{1, 3, 5}, false},
+		{"list of ranges", []string{"1-2,4-5"}, []int{1, 2, 4, 5}, false},
+		{"spaces and empty items", []string{" 1 , ,2"}, []int{1, 2}, false},
+		{"all", []string{"all"}, registered, false},
+		{"day past twelve", []string{"20"}, []int{20}, false},
+		{"duplicates kept in order", []string{"2", "1-2"}, []int{2, 1, 2}, false},
+		{"reversed range", []string{"5-2"}, nil, true},
+		{"range over a gap", []string{"5-7"}, nil, true},
+		{"unregistered day", []string{"6"}, nil, true},
+		{"zero", []string{"0"}, nil, true},
+		{"negative", []string{"-3"}, nil, true},
+		{"garbage", []string{"five"}, nil, true},
+		{"open range", []string{"3-"}, nil, true},
+		{"garbage in list", []string{"1,x"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDaySelection(tt.specs, registered)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDaySelection(%q) error = %v, wantErr %v", tt.specs, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("parseDaySelection(%q) = %v, want %v", tt.specs, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDayList(t *testing.T) {
+	tests := []struct {
+		days []int
+		want string
+	}{
+		{nil, "none"},
+		{[]int{4}, "4"},
+		{[]int{1, 2, 3}, "1-3"},
+		{[]int{1, 2, 3, 5}, "1-3,5"},
+		{[]int{1, 3, 5}, "1,3,5"},
+		{[]int{1, 2, 4, 5, 12}, "1-2,4-5,12"},
+	}
+	for _, tt := range tests {
+		if got := formatDayList(tt.days); got != tt.want {
+			t.Errorf("formatDayList(%v) = %q, want %q", tt.days, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc2025/days"
+	"aoc2025/puzzleinput"
+)
+
+// Defaults for "aoc2025 serve".
+const (
+	defaultServeAddr    = "127.0.0.1:8080"
+	defaultSolveTimeout = 30 * time.Second
+
+	// maxInputBytes caps the size of a puzzle input accepted by the HTTP API.
+	// Real inputs are a few tens of kilobytes.
+	maxInputBytes = 1 << 20
+)
+
+// runServe implements "aoc2025 serve [--year YYYY] [--addr HOST:PORT]
+// [--timeout DURATION] [--max-solves N]": it exposes the registry over HTTP
+// until interrupted and returns the process exit code. See newServeHandler for
+// the routes.
+func runServe(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	addr := defaultServeAddr
+	timeout := defaultSolveTimeout
+	maxSolves := runtime.NumCPU()
+	for i := 0; i < len(args); i++ {
+		name, value, hasValue := strings.Cut(args[i], "=")
+		if !hasValue {
+			if i+1 >= len(args) {
+				fmt.Println("Usage: ./aoc2025 serve [--year YYYY] [--addr HOST:PORT] [--timeout DURATION] [--max-solves N]")
+				return 1
+			}
+			i++
+			value = args[i]
+		}
+
+		switch name {
+		case "--addr":
+			addr = value
+		case "--timeout":
+			d, err := time.ParseDuration(value)
+			if err != nil || d <= 0 {
+				fmt.Printf("invalid %s value: %s\n", name, value)
+				return 1
+			}
+			timeout = d
+		case "--max-solves":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				fmt.Printf("invalid %s value: %s\n", name, value)
+				return 1
+			}
+			maxSolves = n
+		default:
+			fmt.Println("Usage: ./aoc2025 serve [--year YYYY] [--addr HOST:PORT] [--timeout DURATION] [--max-solves N]")
+			return 1
+		}
+	}
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           newServeHandler(year, timeout, maxSolves),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      timeout + 10*time.Second,
+		IdleTimeout:       time.Minute,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	errc := make(chan error, 1)
+	go func() { errc <- srv.ListenAndServe() }()
+	fmt.Printf("Serving %d solvers on http://%s (Ctrl-C to stop)\n", year, addr)
+
+	select {
+	case err := <-errc:
+		fmt.Printf("Server failed: %v\n", err)
+		return 1
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		fmt.Printf("Shutdown failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// jsonDayInfo is the machine-readable form of days.Info listed by GET /v1/days.
+type jsonDayInfo struct {
+	Year         int      `json:"year"`
+	Day          int      `json:"day"`
+	Title        string   `json:"title,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Author       string   `json:"author,omitempty"`
+	Part2Missing bool     `json:"part2_missing,omitempty"`
+}
+
+// newServeHandler returns the HTTP API over the solver registry:
+//
+//	GET  /v1/days          list the registered days of year
+//	POST /v1/days/{day}    solve day for the input in the request body
+//
+// POST accepts ?part=1 or ?part=2 to solve a single part and answers with the
+// same JSON object as "--format json". Every request gets a fresh solver
+// instance, bodies over maxInputBytes are rejected, and solving is abandoned
+// after timeout. At most maxSolves requests solve at once; the rest are turned
+// away with 503 rather than queued.
+//
+// Days that still implement only the legacy Solution interface cannot be
+// interrupted: when their timeout fires the response is sent, but the part
+// keeps computing on its own goroutine until it returns (see days.Wait). Its
+// slot stays taken until then, so abandoned work still counts against
+// maxSolves.
+func newServeHandler(year int, timeout time.Duration, maxSolves int) http.Handler {
+	mux := http.NewServeMux()
+	solving := make(chan struct{}, maxSolves)
+
+	mux.HandleFunc("GET /v1/days", func(w http.ResponseWriter, r *http.Request) {
+		infos := []jsonDayInfo{}
+		for _, info := range days.List() {
+			if info.Year != year {
+				continue
+			}
+			infos = append(infos, jsonDayInfo{
+				Year:         info.Year,
+				Day:          info.Day,
+				Title:        info.Title,
+				Tags:         info.Tags,
+				Author:       info.Author,
+				Part2Missing: info.Part2Missing,
+			})
+		}
+		writeJSON(w, http.StatusOK, infos)
+	})
+
+	mux.HandleFunc("POST /v1/days/{day}", func(w http.ResponseWriter, r *http.Request) {
+		day, err := strconv.Atoi(r.PathValue("day"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid day %q", r.PathValue("day")))
+			return
+		}
+		info, ok := days.Lookup(year, day)
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("no solver for day %d", day))
+			return
+		}
+
+		part := 0
+		if p := r.URL.Query().Get("part"); p != "" {
+			part, err = strconv.Atoi(p)
+			if err != nil || (part != 1 && part != 2) {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid part %q (want 1 or 2)", p))
+				return
+			}
+		}
+
+		lines, err := puzzleinput.Read(http.MaxBytesReader(w, r.Body, maxInputBytes))
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("input exceeds %d bytes", tooLarge.Limit))
+			return
+		case err != nil:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("reading input: %v", err))
+			return
+		case len(lines) == 0:
+			writeError(w, http.StatusBadRequest, "empty input")
+			return
+		}
+
+		select {
+		case solving <- struct{}{}:
+		default:
+			w.Header().Set("Retry-After", "1")
+			writeError(w, http.StatusServiceUnavailable, fmt.Sprintf("too many solves in progress (limit %d)", maxSolves))
+			return
+		}
+		solver, _ := days.GetSolverYear(year, day)
+		// A legacy solver keeps computing after its timeout, so the slot is
+		// held until it really stops rather than until the response is sent.
+		defer func() {
+			go func() {
+				days.Wait(solver)
+				<-solving
+			}()
+		}()
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		result := solveWith(ctx, solver, year, day, part, func(int, int) ([]string, error) {
+			return lines, nil
+		})
+
+		status := http.StatusOK
+		switch {
+		case result.Err != nil:
+			status = http.StatusUnprocessableEntity
+		case errors.Is(result.Part1.Err, context.DeadlineExceeded),
+			errors.Is(result.Part2.Err, context.DeadlineExceeded):
+			status = http.StatusGatewayTimeout
+		}
+		out := toJSON(result, nil)
+		out.Title = info.Title
+		writeJSON(w, status, out)
+	})
+
+	return mux
+}
+
+// writeJSON writes v as the JSON response body with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a {"error": msg} JSON response.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"aoc2025/days"
+)
+
+const day01Example = "L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n"
+
+// slowSolution never finishes part 1 in time for the handler's timeout.
+type slowSolution struct{}
+
+func (slowSolution) SetInput(lines []string) {}
+func (slowSolution) SolvePart1() string      { time.Sleep(time.Second); return "late" }
+func (slowSolution) SolvePart2() string      { return "0" }
+
+// blockingSolution holds part 1 until the test releases it.
+type blockingSolution struct{ started, release chan struct{} }
+
+func (blockingSolution) SetInput(lines []string) {}
+func (s blockingSolution) SolvePart1() string {
+	s.started <- struct{}{}
+	<-s.release
+	return "done"
+}
+func (blockingSolution) SolvePart2() string { return "0" }
+
+// blocking is the solution handed out for day 1999/2; tests replace it
+// before sending requests.
+var blocking blockingSolution
+
+func init() {
+	days.RegisterYear(1999, 1, func() days.Solution { return slowSolution{} })
+	days.RegisterYear(1999, 2, func() days.Solution { return blocking })
+}
+
+func serveRequest(t *testing.T, h http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+	return rec
+}
+
+// serveWhenFree posts body to target, retrying while the handler answers 503
+// because a slot has not been released yet.
+func serveWhenFree(t *testing.T, h http.Handler, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		rec := serveRequest(t, h, "POST", target, body)
+		if rec.Code != http.StatusServiceUnavailable || time.Now().After(deadline) {
+			return rec
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServeListsDays(t *testing.T) {
+	rec := serveRequest(t, newServeHandler(days.DefaultYear, time.Second, 4), "GET", "/v1/days", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d, want 200", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("Content-Type: got %q", ct)
+	}
+
+	var infos []jsonDayInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &infos); err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != len(days.Days()) {
+		t.Fatalf("listed %d days, want %d", len(infos), len(days.Days()))
+	}
+	if infos[0].Day != 1 || infos[0].Title != "Secret Entrance" {
+		t.Fatalf("first day: got %+v", infos[0])
+	}
+}
+
+func TestServeSolvesDay(t *testing.T) {
+	h := newServeHandler(days.DefaultYear, time.Second, 4)
+
+	rec := serveRequest(t, h, "POST", "/v1/days/1", day01Example)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d, want 200 (%s)", rec.Code, rec.Body)
+	}
+	var got jsonDayResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Day != 1 || got.Part1 == nil || got.Part1.Answer != "3" || got.Part2 == nil || got.Part2.Answer != "6" {
+		t.Fatalf("result: got %s", rec.Body)
+	}
+
+	rec = serveRequest(t, h, "POST", "/v1/days/1?part=2", day01Example)
+	got = jsonDayResult{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Part1 != nil || got.Part2 == nil || got.Part2.Answer != "6" {
+		t.Fatalf("part=2 result: got %s", rec.Body)
+	}
+}
+
+func TestServeRejectsBadRequests(t *testing.T) {
+	h := newServeHandler(days.DefaultYear, time.Second, 4)
+
+	tests := []struct {
+		name, method, target, body string
+		status                     int
+		errPart                    string
+	}{
+		{"invalid day", "POST", "/v1/days/x", day01Example, http.StatusBadRequest, "invalid day"},
+		{"unknown day", "POST", "/v1/days/99", day01Example, http.StatusNotFound, "no solver for day 99"},
+		{"invalid part", "POST", "/v1/days/1?part=3", day01Example, http.StatusBadRequest, "invalid part"},
+		{"empty input", "POST", "/v1/days/1", "", http.StatusBadRequest, "empty input"},
+		{"too large", "POST", "/v1/days/1", strings.Repeat("L1\n", maxInputBytes/3+1), http.StatusRequestEntityTooLarge, "exceeds"},
+		{"malformed input", "POST", "/v1/days/1", "L68\nX30\n", http.StatusUnprocessableEntity, "line 2, column 1"},
+		{"wrong method", "GET", "/v1/days/1", "", http.StatusMethodNotAllowed, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveRequest(t, h, tt.method, tt.target, tt.body)
+			if rec.Code != tt.status {
+				t.Fatalf("status: got %d, want %d (%s)", rec.Code, tt.status, rec.Body)
+			}
+			if !strings.Contains(rec.Body.String(), tt.errPart) {
+				t.Fatalf("body: got %s, want it to mention %q", rec.Body, tt.errPart)
+			}
+		})
+	}
+}
+
+func TestServeTimesOut(t *testing.T) {
+	h := newServeHandler(1999, 50*time.Millisecond, 4)
+
+	start := time.Now()
+	rec := serveRequest(t, h, "POST", "/v1/days/1", "x\n")
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("status: got %d, want 504 (%s)", rec.Code, rec.Body)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("request took %v, want it abandoned after the timeout", elapsed)
+	}
+}
+
+func TestServeLimitsConcurrentSolves(t *testing.T) {
+	blocking = blockingSolution{started: make(chan struct{}, 2), release: make(chan struct{})}
+	h := newServeHandler(1999, 5*time.Second, 1)
+
+	first := make(chan *httptest.ResponseRecorder)
+	go func() { first <- serveRequest(t, h, "POST", "/v1/days/2?part=1", "x\n") }()
+	<-blocking.started
+
+	rec := serveRequest(t, h, "POST", "/v1/days/1", "x\n")
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status while busy: got %d, want 503 (%s)", rec.Code, rec.Body)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Error("503 response has no Retry-After header")
+	}
+
+	close(blocking.release)
+	if rec := <-first; rec.Code != http.StatusOK {
+		t.Fatalf("first request: got %d, want 200 (%s)", rec.Code, rec.Body)
+	}
+
+	// The slot is free again once the first solve has finished.
+	rec = serveWhenFree(t, h, "/v1/days/2?part=1", "x\n")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status after release: got %d, want 200 (%s)", rec.Code, rec.Body)
+	}
+}
+
+func TestServeHoldsSlotForAbandonedSolve(t *testing.T) {
+	blocking = blockingSolution{started: make(chan struct{}, 2), release: make(chan struct{})}
+	h := newServeHandler(1999, 50*time.Millisecond, 1)
+
+	rec := serveRequest(t, h, "POST", "/v1/days/2?part=1", "x\n")
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("status: got %d, want 504 (%s)", rec.Code, rec.Body)
+	}
+
+	// The abandoned part is still computing, so it still holds the only slot.
+	rec = serveRequest(t, h, "POST", "/v1/days/1", "x\n")
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status while the abandoned solve runs: got %d, want 503 (%s)", rec.Code, rec.Body)
+	}
+
+	close(blocking.release)
+	rec = serveWhenFree(t, h, "/v1/days/2?part=1", "x\n")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status after the abandoned solve finished: got %d, want 200 (%s)", rec.Code, rec.Body)
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"aoc2025/aocnet"
+	"aoc2025/days"
+)
+
+// sessionLifetime is roughly how long an adventofcode.com session cookie stays
+// valid. Stored tokens older than this draw a warning.
+const sessionLifetime = 30 * 24 * time.Hour
+
+// sessionWarning makes sure problems with the stored session are reported
+// once per run rather than once per day fetched.
+var sessionWarning sync.Once
+
+// sessionPath returns where "aoc2025 login" keeps the session token:
+// $XDG_CONFIG_HOME/aoc/session, or ~/.config/aoc/session when XDG_CONFIG_HOME
+// is not set.
+func sessionPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "aoc", "session"), nil
+}
+
+// sessionToken returns the session to authenticate with: AOC_SESSION when set,
+// otherwise the token saved by "aoc2025 login", or "" when there is neither.
+// A stored token that is unreadable, readable by other users or old enough to
+// have expired is reported on stderr.
+func sessionToken() string {
+	if session := os.Getenv("AOC_SESSION"); session != "" {
+		return session
+	}
+
+	token, saved, err := loadStoredSession()
+	if err != nil {
+		sessionWarning.Do(func() { fmt.Fprintf(os.Stderr, "Ignoring stored session: %v\n", err) })
+		return ""
+	}
+	if token != "" && time.Since(saved) > sessionLifetime {
+		sessionWarning.Do(func() {
+			fmt.Fprintf(os.Stderr, "The stored session was saved %d days ago and may have expired; run ./aoc2025 login --check\n",
+				int(time.Since(saved)/(24*time.Hour)))
+		})
+	}
+	return token
+}
+
+// loadStoredSession reads the token saved by "aoc2025 login" and when it was
+// saved. It returns an empty token when nothing is stored, and refuses a file
+// that other users can read or write.
+func loadStoredSession() (string, time.Time, error) {
+	path, err := sessionPath()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", time.Time{}, nil
+	} else if err != nil {
+		return "", time.Time{}, err
+	}
+	if perm := info.Mode().Perm(); runtime.GOOS != "windows" && perm&0077 != 0 {
+		return "", time.Time{}, fmt.Errorf("%s is accessible by other users (mode %04o); run chmod 600 %s", path, perm, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", time.Time{}, fmt.Errorf("%s is empty", path)
+	}
+	return token, info.ModTime(), nil
+}
+
+// saveSession stores token for later runs, readable by the current user only,
+// and returns the path it was written to.
+func saveSession(token string) (string, error) {
+	path, err := sessionPath()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(path, []byte(token+"\n"), 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// parseSessionToken cleans up a pasted token, accepting the bare cookie value
+// as well as a copied "session=..." pair. The value must be hexadecimal, as
+// adventofcode.com tokens are.
+func parseSessionToken(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "session=")
+	s = strings.TrimSuffix(s, ";")
+	if s == "" {
+		return "", errors.New("no session token given")
+	}
+	for _, c := range s {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return "", errors.New("that does not look like a session token; expected only hexadecimal digits")
+		}
+	}
+	return s, nil
+}
+
+// runLogin implements "aoc2025 login [--check]": it reads a session token
+// pasted on standard input, validates it against adventofcode.com and saves
+// it to sessionPath. With --check it validates the session currently in use
+// instead. It returns the process exit code.
+func runLogin(args []string) int {
+	check := false
+	for _, arg := range args {
+		if arg != "--check" {
+			fmt.Println("Usage: ./aoc2025 login [--check]")
+			return 1
+		}
+		check = true
+	}
+	if check {
+		return checkLogin()
+	}
+
+	fmt.Fprint(os.Stderr, "Paste the value of your adventofcode.com session cookie and press Enter: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		fmt.Printf("Error reading the token: %v\n", err)
+		return 1
+	}
+	token, err := parseSessionToken(line)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	user, err := aocClient(days.DefaultYear).CheckSession(token)
+	if err != nil {
+		fmt.Printf("The token was not accepted: %s\n", describeFetchError(err))
+		return 1
+	}
+
+	path, err := saveSession(token)
+	if err != nil {
+		fmt.Printf("Error saving the session: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Logged in as %s. Session saved to %s\n", user, path)
+	if os.Getenv("AOC_SESSION") != "" {
+		fmt.Println("Note: AOC_SESSION is set and takes precedence over the saved session.")
+	}
+	return 0
+}
+
+// checkLogin validates the session sessionToken would use and reports where
+// it came from.
+func checkLogin() int {
+	token := sessionToken()
+	if token == "" {
+		fmt.Println("No session found. Run ./aoc2025 login to save one.")
+		return 1
+	}
+
+	source := "from AOC_SESSION"
+	if os.Getenv("AOC_SESSION") == "" {
+		path, _ := sessionPath()
+		source = "saved in " + path
+		if _, saved, err := loadStoredSession(); err == nil {
+			source += fmt.Sprintf(" %d days ago", int(time.Since(saved)/(24*time.Hour)))
+		}
+	}
+
+	user, err := aocClient(days.DefaultYear).CheckSession(token)
+	if err != nil {
+		fmt.Printf("The session %s is not valid: %s\n", source, describeFetchError(err))
+		return 1
+	}
+	fmt.Printf("Logged in as %s (session %s)\n", user, source)
+	return 0
+}
+
+// withoutSession returns env minus AOC_SESSION, so child processes that do
+// not need the token do not receive it.
+func withoutSession(env []string) []string {
+	var out []string
+	for _, kv := range env {
+		if !strings.HasPrefix(kv, "AOC_SESSION=") {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
+
+// redactSession removes the session currently in use from s before it is
+// printed.
+func redactSession(s string) string {
+	return aocnet.Redact(s, sessionToken())
+}
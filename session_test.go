@@ -0,0 +1,88 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestSessionStore(t *testing.T) {
+	config := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", config)
+	t.Setenv("AOC_SESSION", "")
+
+	if got := sessionToken(); got != "" {
+		t.Fatalf("sessionToken with nothing stored = %q, want empty", got)
+	}
+
+	path, err := saveSession("53616c7465645f5f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(config, "aoc", "session"); path != want {
+		t.Fatalf("saveSession path = %s, want %s", path, want)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0600 {
+		t.Fatalf("session file mode = %04o, want 0600", info.Mode().Perm())
+	}
+	if got := sessionToken(); got != "53616c7465645f5f" {
+		t.Fatalf("sessionToken = %q, want the stored token", got)
+	}
+
+	t.Setenv("AOC_SESSION", "0123abcd")
+	if got := sessionToken(); got != "0123abcd" {
+		t.Fatalf("sessionToken = %q, want AOC_SESSION to take precedence", got)
+	}
+}
+
+func TestSessionStoreRejectsSharedFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	path, err := saveSession("53616c7465645f5f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := loadStoredSession(); err == nil {
+		t.Fatal("loadStoredSession accepted a world-readable session file")
+	}
+}
+
+func TestParseSessionToken(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"53616c7465645f5f\n", "53616c7465645f5f", false},
+		{"  session=53616C7465645F5F; ", "53616C7465645F5F", false},
+		{"", "", true},
+		{"not a token", "", true},
+		{"session=", "", true},
+	}
+	for _, tt := range tests {
+		got, err := parseSessionToken(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseSessionToken(%q) = %q, %v; want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestWithoutSession(t *testing.T) {
+	env := []string{"HOME=/home/elf", "AOC_SESSION=53616c7465645f5f", "AOC_SESSION_EXTRA=1"}
+	want := []string{"HOME=/home/elf", "AOC_SESSION_EXTRA=1"}
+	if got := withoutSession(env); !slices.Equal(got, want) {
+		t.Fatalf("withoutSession = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+
+	"aoc2025/aocnet"
+	"aoc2025/days"
+)
+
+// runSubmit implements "aoc2025 submit <day> <part>": it solves the requested
+// part on the day's input, submits the answer and reports the verdict. Days
+// whose part 2 is marked missing are refused. It returns the process exit
+// code.
+func runSubmit(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+	if len(args) != 2 {
+		fmt.Println("Usage: ./aoc2025 submit [--year YYYY] <day> <part>")
+		return 1
+	}
+
+	day, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Printf("Invalid day: %s\n", args[0])
+		return 1
+	}
+	part, err := strconv.Atoi(args[1])
+	if err != nil || (part != 1 && part != 2) {
+		fmt.Printf("Invalid part: %s\n", args[1])
+		return 1
+	}
+
+	// A missing part 2 answers with a placeholder; submitting it would only
+	// waste a guess and start the server's wait timer.
+	if info, ok := days.Lookup(year, day); ok && part == 2 && info.Part2Missing {
+		fmt.Printf("Day %d part 2 not implemented; not submitting\n", day)
+		return 1
+	}
+
+	session := sessionToken()
+	if session == "" {
+		fmt.Println("Submitting needs a session; run ./aoc2025 login or set AOC_SESSION.")
+		return 1
+	}
+
+	solver, ok := days.GetSolverYear(year, day)
+	if !ok {
+		fmt.Printf("No solver for day %d\n", day)
+		return 1
+	}
+
+	lines, err := FetchOrReadInput(year, day)
+	if err != nil {
+		fmt.Printf("Error loading input for day %d: %v\n", day, err)
+		return 1
+	}
+	if err := solver.Parse(lines); err != nil {
+		fmt.Printf("Error parsing input for day %d: %v\n", day, err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	solve := solver.Part1
+	if part == 2 {
+		solve = solver.Part2
+	}
+	answer, err := solve(ctx)
+	if err != nil {
+		fmt.Printf("Error solving day %d part %d: %v\n", day, part, err)
+		return 1
+	}
+
+	fmt.Printf("Submitting %s for day %d part %d...\n", answer, day, part)
+	res, err := aocClient(year).SubmitAnswer(day, part, answer, session)
+	if err != nil {
+		fmt.Printf("Submission failed: %s\n", describeFetchError(err))
+		return 1
+	}
+
+	fmt.Printf("Result: %s\n", res.Outcome)
+	if res.Wait > 0 {
+		fmt.Printf("Wait %s before submitting again.\n", res.Wait)
+	}
+	if res.Outcome == aocnet.OutcomeUnknown && res.Message != "" {
+		fmt.Println(res.Message)
+	}
+
+	switch res.Outcome {
+	case aocnet.OutcomeCorrect, aocnet.OutcomeAlreadySolved:
+		return 0
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"aoc2025/days"
+)
+
+func TestSubmitRefusesMissingPart2(t *testing.T) {
+	var day int
+	for _, info := range days.List() {
+		if info.Year == days.DefaultYear && info.Part2Missing {
+			day = info.Day
+		}
+	}
+	if day == 0 {
+		t.Skip("no day has part 2 marked missing")
+	}
+
+	// The input cache is empty, so a day that got past the check would fail
+	// on loading its input instead, with a different message.
+	t.Setenv("AOC_SESSION", "secret-session")
+	t.Setenv("AOC_ONLINE", "")
+	t.Setenv("AOC_INPUT_DIR", t.TempDir())
+	out := captureStdout(t, func() {
+		if code := runSubmit([]string{strconv.Itoa(day), "2"}); code != 1 {
+			t.Errorf("runSubmit exit code = %d, want 1", code)
+		}
+	})
+	if !strings.Contains(out, "part 2 not implemented") {
+		t.Fatalf("runSubmit printed %q, want it to refuse the missing part 2", out)
+	}
+}
+
+// captureStdout returns what f prints to standard output.
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan string)
+	go func() {
+		out, _ := io.ReadAll(r)
+		done <- string(out)
+	}()
+	f()
+	w.Close()
+	return <-done
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timingRow holds the measured phases for one day in the timing summary. Input
+// is the time spent reading the input: Parse for --time, SetInput for bench.
+// A negative duration marks a phase that was not measured.
+type timingRow struct {
+	Day   int
+	Input time.Duration
+	Part1 time.Duration
+	Part2 time.Duration
+	Full  time.Duration
+}
+
+// timingRowFor extracts the phase durations from a solved day. Parts skipped
+// by --part are marked as not measured.
+func timingRowFor(r dayResult) timingRow {
+	row := timingRow{
+		Day:   r.Day,
+		Input: r.Parse,
+		Part1: r.Part1.Duration,
+		Part2: r.Part2.Duration,
+		Full:  r.Parse + r.Part1.Duration + r.Part2.Duration,
+	}
+	if r.Part1.Skipped {
+		row.Part1 = -1
+	}
+	if r.Part2.Skipped {
+		row.Part2 = -1
+	}
+	return row
+}
+
+// Names of the input phase column in the timing table.
+const (
+	phaseParse    = "Parse"
+	phaseSetInput = "SetInput"
+)
+
+// writeTimingTable prints rows as the markdown table used in the README
+// benchmark summary, followed by a total row. inputPhase names what the Input
+// column measured, phaseParse or phaseSetInput.
+func writeTimingTable(w io.Writer, inputPhase string, rows []timingRow) {
+	fmt.Fprintf(w, "| Day   | %-13s | SolvePart1 (µs) | SolvePart2 (µs) | FullPipeline (µs) |\n", inputPhase+" (µs)")
+	fmt.Fprintln(w, "| ----- | ------------- | --------------- | --------------- | ----------------- |")
+
+	total := timingRow{Input: -1, Part1: -1, Part2: -1, Full: -1}
+	for _, row := range rows {
+		fmt.Fprintf(w, "| %02d    | %-13s | %-15s | %-15s | %-17s |\n",
+			row.Day,
+			formatMicros(row.Input),
+			formatMicros(row.Part1),
+			formatMicros(row.Part2),
+			formatMicros(row.Full))
+
+		addMeasured(&total.Input, row.Input)
+		addMeasured(&total.Part1, row.Part1)
+		addMeasured(&total.Part2, row.Part2)
+		addMeasured(&total.Full, row.Full)
+	}
+
+	fmt.Fprintf(w, "| %-5s | %-13s | %-15s | %-15s | %-17s |\n",
+		"Total",
+		formatMicros(total.Input),
+		formatMicros(total.Part1),
+		formatMicros(total.Part2),
+		formatMicros(total.Full))
+}
+
+// addMeasured adds d to *sum when d was measured. A negative *sum means
+// nothing has been added yet, so a column with no measurements stays "-".
+func addMeasured(sum *time.Duration, d time.Duration) {
+	if d < 0 {
+		return
+	}
+	*sum = max(*sum, 0) + d
+}
+
+// formatMicros renders d in microseconds with two decimals and underscores as
+// thousands separators, e.g. 6_333.86; negative durations render as "-".
+func formatMicros(d time.Duration) string {
+	if d < 0 {
+		return "-"
+	}
+
+	s := strconv.FormatFloat(float64(d)/float64(time.Microsecond), 'f', 2, 64)
+	whole, frac, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	for i, c := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte('_')
+		}
+		b.WriteRune(c)
+	}
+	return b.String() + "." + frac
+}
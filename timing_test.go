@@ -0,0 +1,48 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestWriteTimingTableAligned(t *testing.T) {
+	rows := []timingRow{
+		{Day: 1, Input: 76 * time.Microsecond, Part1: 12 * time.Microsecond, Part2: 419 * time.Microsecond, Full: 508 * time.Microsecond},
+		{Day: 12, Input: 6333 * time.Microsecond, Part1: 5 * time.Microsecond, Part2: -1, Full: 6338 * time.Microsecond},
+	}
+	for _, phase := range []string{phaseParse, phaseSetInput} {
+		var b strings.Builder
+		writeTimingTable(&b, phase, rows)
+
+		lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+		if len(lines) != 5 {
+			t.Fatalf("got %d lines, want header, separator, 2 rows and total:\n%s", len(lines), b.String())
+		}
+		if !strings.HasPrefix(lines[0], "| Day   | "+phase+" (µs) ") {
+			t.Errorf("header %q does not name the %s column", lines[0], phase)
+		}
+		if !strings.HasPrefix(lines[4], "| Total |") {
+			t.Fatalf("last line is not the total row: %q", lines[4])
+		}
+		want := pipeColumns(lines[0])
+		for _, line := range lines[1:] {
+			if got := pipeColumns(line); !slices.Equal(got, want) {
+				t.Errorf("columns of %q are %v, want %v as in the header", line, got, want)
+			}
+		}
+	}
+}
+
+// pipeColumns returns the rune offsets of the column separators in line.
+func pipeColumns(line string) []int {
+	var cols []int
+	for i, r := range line {
+		if r == '|' {
+			cols = append(cols, utf8.RuneCountInString(line[:i]))
+		}
+	}
+	return cols
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"aoc2025/aocnet"
+)
+
+// unlockGrace is added to the official unlock instant before fetching, so a
+// slightly fast local clock does not race the server's release.
+const unlockGrace = time.Second
+
+// waitForUnlock blocks until the puzzle for day of year has unlocked, printing
+// a countdown to stderr once per second. It returns early with ctx's error if
+// the wait is cancelled.
+func waitForUnlock(ctx context.Context, year, day int) error {
+	remaining := time.Until(aocnet.UnlockTime(year, day))
+	if remaining <= 0 {
+		return nil
+	}
+	remaining += unlockGrace
+
+	deadline := time.Now().Add(remaining)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		left := time.Until(deadline)
+		if left <= 0 {
+			fmt.Fprintf(os.Stderr, "\rDay %d is unlocked!%20s\n", day, "")
+			return nil
+		}
+		fmt.Fprintf(os.Stderr, "\rDay %d unlocks in %s ", day, formatCountdown(left))
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			fmt.Fprintln(os.Stderr)
+			return ctx.Err()
+		}
+	}
+}
+
+// formatCountdown renders d as [Nd ]HH:MM:SS, rounding up to whole seconds.
+func formatCountdown(d time.Duration) string {
+	secs := int64((d + time.Second - 1) / time.Second)
+	days := secs / 86400
+	secs %= 86400
+
+	clock := fmt.Sprintf("%02d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+	if days > 0 {
+		return fmt.Sprintf("%dd %s", days, clock)
+	}
+	return clock
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc2025/days"
+)
+
+// defaultWatchInterval is how often watch polls the files it follows.
+const defaultWatchInterval = 500 * time.Millisecond
+
+// fileStamp is what watch compares between polls to notice that a file was
+// rewritten. A missing file has the zero stamp.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFile(path string) fileStamp {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}
+}
+
+// runWatch implements "aoc2025 watch [--year YYYY] [--input FILE] [--source]
+// [--interval DURATION] <day>": it solves the day, then polls its input file
+// and re-solves whenever the file changes, printing the answers and timings
+// next to those of the previous run. With --source it also follows
+// days/dayNN.go and solves through "go run" so that edits to the solver take
+// effect. It runs until interrupted and returns the process exit code.
+func runWatch(args []string) int {
+	year, args, err := splitYearFlag(args)
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	var inputFile string
+	source := false
+	interval := defaultWatchInterval
+	day := 0
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(arg, "=")
+
+		// needValue takes the flag value from "--flag=value" or, failing
+		// that, from the next argument.
+		needValue := func() bool {
+			if hasValue {
+				return true
+			}
+			if i+1 >= len(args) {
+				fmt.Printf("%s requires a value\n", name)
+				return false
+			}
+			i++
+			value = args[i]
+			return true
+		}
+
+		switch name {
+		case "-i", "--input":
+			if !needValue() {
+				return 1
+			}
+			if value == "-" {
+				fmt.Println("watch needs a file to follow; standard input cannot be watched")
+				return 1
+			}
+			inputFile = value
+		case "-s", "--source":
+			source = true
+		case "--interval":
+			if !needValue() {
+				return 1
+			}
+			d, err := time.ParseDuration(value)
+			if err != nil || d <= 0 {
+				fmt.Printf("invalid %s value: %s\n", name, value)
+				return 1
+			}
+			interval = d
+		default:
+			n, err := strconv.Atoi(arg)
+			if err != nil || day != 0 {
+				fmt.Println("Usage: ./aoc2025 watch [--year YYYY] [--input FILE] [--source] [--interval DURATION] <day>")
+				return 1
+			}
+			day = n
+		}
+	}
+	if day == 0 {
+		fmt.Println("Usage: ./aoc2025 watch [--year YYYY] [--input FILE] [--source] [--interval DURATION] <day>")
+		return 1
+	}
+	if _, ok := days.GetYear(year, day); !ok {
+		fmt.Printf("No solver for day %d\n", day)
+		return 1
+	}
+
+	// The cached input is verified against its checksum like any other read
+	// of the cache; a file named with --input is taken as it is.
+	watched := inputFile
+	readInput := func() ([]string, error) { return ReadLocalInput(inputFile) }
+	if inputFile == "" {
+		var cachePath string
+		cachePath, watched = watchedInputPath(year, day)
+		readInput = func() ([]string, error) {
+			lines, err := readCacheFile(cachePath, year, day)
+			if errors.Is(err, errCacheCorrupt) {
+				err = explainCorruptCache(err, year, day)
+			}
+			return lines, err
+		}
+	}
+	files := []string{watched}
+	if source {
+		files = append(files, filepath.Join("days", fmt.Sprintf("day%02d.go", day)))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Printf("Watching %s (Ctrl-C to stop)\n", strings.Join(files, ", "))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	stamps := make([]fileStamp, len(files))
+	var prev *dayResult
+	for first := true; ; first = false {
+		var changed []string
+		for i, path := range files {
+			if stamp := statFile(path); stamp != stamps[i] {
+				stamps[i] = stamp
+				changed = append(changed, path)
+			}
+		}
+
+		if first || len(changed) > 0 {
+			reason := "initial run"
+			if !first {
+				reason = strings.Join(changed, ", ") + " changed"
+			}
+
+			var r dayResult
+			if !source {
+				r = solveDay(ctx, year, day, 0, func(int, int) ([]string, error) {
+					return readInput()
+				})
+			} else if lines, err := readInput(); err != nil {
+				r = dayResult{Year: year, Day: day, Err: fmt.Errorf("Error loading input for day %d: %w", day, err)}
+			} else {
+				r = solveWithGoRun(ctx, year, day, lines)
+			}
+			if ctx.Err() != nil {
+				return 0
+			}
+
+			printWatchResult(os.Stdout, time.Now(), reason, prev, r)
+			prev = &r
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchedInputPath returns the cached input watch reads by default and the
+// file it polls for changes: input/YYYY/dayNN.txt, or the legacy
+// input/dayNN.txt when only that exists. When a location has only its
+// encrypted copy, that .enc file is the one polled.
+func watchedInputPath(year, day int) (cachePath, watched string) {
+	candidates := []string{inputPath(year, day)}
+	if year == days.DefaultYear {
+		candidates = append(candidates, legacyInputPath(day))
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path, path
+		}
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(encryptedPath(path)); err == nil {
+			return path, encryptedPath(path)
+		}
+	}
+	return candidates[0], candidates[0]
+}
+
+// solveWithGoRun solves day in a fresh "go run" of the current module, so the
+// latest solver source is compiled in, and reads back its JSON report. The
+// input is handed over on standard input, already decrypted and verified by
+// the caller. Build errors are passed through on stderr.
+func solveWithGoRun(ctx context.Context, year, day int, lines []string) dayResult {
+	cmd := exec.CommandContext(ctx, "go", "run", ".",
+		"--format", "json",
+		"--year", strconv.Itoa(year),
+		"--input", "-",
+		strconv.Itoa(day))
+	cmd.Stdin = bytes.NewReader(joinLines(lines))
+	cmd.Stderr = os.Stderr
+	cmd.Env = withoutSession(os.Environ())
+
+	out, err := cmd.Output()
+	var results []jsonDayResult
+	if jsonErr := json.Unmarshal(out, &results); jsonErr != nil || len(results) != 1 {
+		if err == nil {
+			err = fmt.Errorf("unexpected output %q", out)
+		}
+		return dayResult{Year: year, Day: day, Err: fmt.Errorf("go run failed: %w", err)}
+	}
+	return fromJSON(results[0])
+}
+
+// printWatchResult prints one watch run: what triggered it, the parse time and
+// each part's answer and time, marking answers that changed since prev and how
+// much each timing moved. prev is nil for the first run.
+func printWatchResult(w io.Writer, when time.Time, reason string, prev *dayResult, r dayResult) {
+	fmt.Fprintf(w, "\n[%s] Day %d: %s\n", when.Format("15:04:05"), r.Day, reason)
+	if r.Err != nil {
+		fmt.Fprintf(w, "  %v\n", r.Err)
+		return
+	}
+
+	var prevParse time.Duration
+	var prevPart1, prevPart2 *partOutcome
+	if prev != nil && prev.Err == nil {
+		prevParse = prev.Parse
+		prevPart1, prevPart2 = &prev.Part1, &prev.Part2
+	}
+
+	fmt.Fprintf(w, "  Parse:  %s µs%s\n", formatMicros(r.Parse), timingDelta(prevParse, r.Parse))
+	printWatchPart(w, 1, prevPart1, r.Part1)
+	printWatchPart(w, 2, prevPart2, r.Part2)
+}
+
+func printWatchPart(w io.Writer, n int, prev *partOutcome, p partOutcome) {
+	switch {
+	case p.NotImplemented:
+		fmt.Fprintf(w, "  Part %d: not implemented\n", n)
+		return
+	case p.Skipped:
+		return
+	case p.Err != nil:
+		fmt.Fprintf(w, "  Part %d: error: %v\n", n, p.Err)
+		return
+	}
+
+	change := ""
+	var prevDuration time.Duration
+	if prev != nil && !prev.Skipped && prev.Err == nil {
+		change = " (unchanged)"
+		if prev.Answer != p.Answer {
+			change = fmt.Sprintf(" (was %s)", prev.Answer)
+		}
+		prevDuration = prev.Duration
+	}
+	fmt.Fprintf(w, "  Part %d: %s%s  %s µs%s\n", n, p.Answer, change, formatMicros(p.Duration), timingDelta(prevDuration, p.Duration))
+}
+
+// timingDelta renders the relative change from prev to cur, e.g. " (-12.5%)",
+// or nothing when there is no previous timing to compare with.
+func timingDelta(prev, cur time.Duration) string {
+	if prev <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%+.1f%%)", 100*float64(cur-prev)/float64(prev))
+}
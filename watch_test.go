@@ -0,0 +1,152 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatchedInputPath(t *testing.T) {
+	t.Setenv("AOC_INPUT_DIR", t.TempDir())
+	path := inputPath(2024, 4)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if cached, watched := watchedInputPath(2024, 4); cached != path || watched != path {
+		t.Fatalf("no input: got %s, %s; want %s for both", cached, watched, path)
+	}
+
+	// With only the encrypted copy, the plaintext path is read (and
+	// decrypted) but the .enc file is what changes.
+	if err := os.WriteFile(encryptedPath(path), []byte("sealed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if cached, watched := watchedInputPath(2024, 4); cached != path || watched != encryptedPath(path) {
+		t.Fatalf("encrypted only: got %s, %s; want %s, %s", cached, watched, path, encryptedPath(path))
+	}
+
+	if err := os.WriteFile(path, []byte("XMAS\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if cached, watched := watchedInputPath(2024, 4); cached != path || watched != path {
+		t.Fatalf("plaintext: got %s, %s; want %s for both", cached, watched, path)
+	}
+}
+
+func TestPrintWatchResult(t *testing.T) {
+	when := time.Date(2025, 12, 5, 9, 30, 15, 0, time.UTC)
+	first := dayResult{
+		Day:   5,
+		Parse: 100 * time.Microsecond,
+		Part1: partOutcome{Answer: "3", Duration: 200 * time.Microsecond},
+		Part2: partOutcome{Answer: "14", Duration: 400 * time.Microsecond},
+	}
+	second := dayResult{
+		Day:   5,
+		Parse: 150 * time.Microsecond,
+		Part1: partOutcome{Answer: "3", Duration: 100 * time.Microsecond},
+		Part2: partOutcome{Answer: "15", Duration: 500 * time.Microsecond},
+	}
+
+	tests := []struct {
+		name   string
+		prev   *dayResult
+		r      dayResult
+		reason string
+		want   string
+	}{
+		{
+			name:   "first run",
+			r:      first,
+			reason: "initial run",
+			want: `
+[09:30:15] Day 5: initial run
+  Parse:  100.00 µs
+  Part 1: 3  200.00 µs
+  Part 2: 14  400.00 µs
+`,
+		},
+		{
+			name:   "changes",
+			prev:   &first,
+			r:      second,
+			reason: "input/2025/day05.txt changed",
+			want: `
+[09:30:15] Day 5: input/2025/day05.txt changed
+  Parse:  150.00 µs (+50.0%)
+  Part 1: 3 (unchanged)  100.00 µs (-50.0%)
+  Part 2: 15 (was 14)  500.00 µs (+25.0%)
+`,
+		},
+		{
+			name:   "day error",
+			prev:   &first,
+			r:      dayResult{Day: 5, Err: errors.New("Error parsing input for day 5: line 2, column 4: invalid range end \"x\"")},
+			reason: "input/2025/day05.txt changed",
+			want: `
+[09:30:15] Day 5: input/2025/day05.txt changed
+  Error parsing input for day 5: line 2, column 4: invalid range end "x"
+`,
+		},
+		{
+			name: "part error and not implemented",
+			prev: &first,
+			r: dayResult{
+				Day:   5,
+				Parse: 100 * time.Microsecond,
+				Part1: partOutcome{Err: errors.New("panic: index out of range"), Duration: time.Microsecond},
+				Part2: partOutcome{Skipped: true, NotImplemented: true},
+			},
+			reason: "days/day05.go changed",
+			want: `
+[09:30:15] Day 5: days/day05.go changed
+  Parse:  100.00 µs (+0.0%)
+  Part 1: error: panic: index out of range
+  Part 2: not implemented
+`,
+		},
+		{
+			name:   "after a failed run",
+			prev:   &dayResult{Day: 5, Err: errors.New("Error loading input for day 5")},
+			r:      first,
+			reason: "input/2025/day05.txt changed",
+			want: `
+[09:30:15] Day 5: input/2025/day05.txt changed
+  Parse:  100.00 µs
+  Part 1: 3  200.00 µs
+  Part 2: 14  400.00 µs
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			printWatchResult(&b, when, tt.reason, tt.prev, tt.r)
+			if b.String() != tt.want {
+				t.Fatalf("got:\n%s\nwant:\n%s", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTimingDelta(t *testing.T) {
+	tests := []struct {
+		prev, cur time.Duration
+		want      string
+	}{
+		{0, time.Millisecond, ""},
+		{time.Millisecond, time.Millisecond, " (+0.0%)"},
+		{800 * time.Microsecond, 700 * time.Microsecond, " (-12.5%)"},
+		{time.Millisecond, 3 * time.Millisecond, " (+200.0%)"},
+	}
+	for _, tt := range tests {
+		if got := timingDelta(tt.prev, tt.cur); got != tt.want {
+			t.Errorf("timingDelta(%v, %v) = %q, want %q", tt.prev, tt.cur, got, tt.want)
+		}
+	}
+}